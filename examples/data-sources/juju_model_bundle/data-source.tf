@@ -0,0 +1,18 @@
+data "juju_model" "my_model" {
+  name  = "default"
+  owner = "admin"
+}
+
+data "juju_model_bundle" "my_model" {
+  model_uuid               = data.juju_model.my_model.uuid
+  strip_machine_placements = true
+}
+
+resource "local_file" "bundle" {
+  filename = "${path.module}/bundle.yaml"
+  content  = data.juju_model_bundle.my_model.bundle
+}
+
+output "charms" {
+  value = { for name, app in data.juju_model_bundle.my_model.applications : name => app.charm }
+}
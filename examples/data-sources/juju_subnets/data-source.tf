@@ -0,0 +1,12 @@
+data "juju_model" "my_model" {
+  name = "default"
+}
+
+data "juju_subnets" "alpha" {
+  model_uuid = data.juju_model.my_model.uuid
+  space      = "alpha"
+}
+
+output "alpha_cidrs" {
+  value = data.juju_subnets.alpha.subnets[*].cidr
+}
@@ -0,0 +1,17 @@
+# Run an action which reads a password every time the configuration is
+# planned or applied, without storing the password in the state.
+ephemeral "juju_action_result" "admin_password" {
+  model_uuid  = juju_model.development.uuid
+  application = juju_application.postgresql.name
+  action      = "get-password"
+
+  parameters = {
+    username = "operator"
+  }
+}
+
+# Read the results of an action which was run once by a juju_action resource.
+ephemeral "juju_action_result" "backup" {
+  model_uuid   = juju_model.development.uuid
+  operation_id = juju_action.backup.operation_id
+}
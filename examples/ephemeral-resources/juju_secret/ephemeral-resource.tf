@@ -0,0 +1,12 @@
+ephemeral "juju_secret" "db_credentials" {
+  model_uuid = juju_model.development.uuid
+  name       = "db-credentials"
+}
+
+# The value can only be used where ephemeral values are allowed, such as
+# provider configuration or write-only arguments.
+provider "postgresql" {
+  host     = "db.example.com"
+  username = ephemeral.juju_secret.db_credentials.value["username"]
+  password = ephemeral.juju_secret.db_credentials.value["password"]
+}
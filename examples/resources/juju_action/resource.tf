@@ -0,0 +1,39 @@
+# Fetch the generated admin password from the leader unit once the
+# application is ready. The action runs again whenever the triggers change.
+resource "juju_action" "admin_password" {
+  model_uuid  = juju_model.development.uuid
+  application = juju_application.postgresql.name
+  action      = "get-password"
+
+  parameters = {
+    username = "operator"
+  }
+
+  triggers = {
+    revision = juju_application.postgresql.charm[0].revision
+  }
+
+  sensitive = true
+}
+
+output "admin_password" {
+  value     = one(values(juju_action.admin_password.sensitive_results))["password"]
+  sensitive = true
+}
+
+# Run an action on specific units, with typed parameters.
+resource "juju_action" "backup" {
+  model_uuid  = juju_model.development.uuid
+  application = juju_application.postgresql.name
+  units       = ["postgresql/0", "postgresql/1"]
+  action      = "create-backup"
+
+  parameters = {
+    type    = "full"
+    retries = 3
+  }
+
+  timeouts {
+    create = "1h"
+  }
+}
@@ -95,3 +95,37 @@ resource "juju_application" "this" {
     "coredns-image" : "ghcr.io/canonical/test:dfb5e3fa84d9476c492c8693d7b2417c0de8742f"
   }
 }
+
+# An application deployed from a local charm. The charm is uploaded to the
+# controller and the application is refreshed whenever the charm is rebuilt.
+resource "juju_application" "local" {
+  model_uuid = juju_model.development.uuid
+
+  charm {
+    path = "${path.module}/charms/my-charm_ubuntu-22.04-amd64.charm"
+  }
+
+  units = 1
+}
+
+# An application which is only considered created once all of its units
+# report an active workload and an idle agent, so that dependent resources
+# such as integrations and offers do not race the charm installation.
+resource "juju_application" "ready" {
+  model_uuid = juju_model.development.uuid
+
+  charm {
+    name = "ubuntu"
+  }
+
+  units = 2
+
+  wait_for {
+    workload_status = ["active"]
+    agent_status    = "idle"
+  }
+
+  timeouts {
+    create = "20m"
+  }
+}
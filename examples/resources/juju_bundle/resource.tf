@@ -0,0 +1,26 @@
+resource "juju_bundle" "wordpress" {
+  model_uuid = juju_model.development.uuid
+
+  bundle = <<-EOT
+    applications:
+      mysql:
+        charm: mysql
+        channel: 8.0/stable
+        num_units: 1
+      wordpress:
+        charm: wordpress
+        num_units: 2
+        expose: true
+    relations:
+      - [wordpress:db, mysql:database]
+  EOT
+
+  overlays = [file("${path.module}/production-overlay.yaml")]
+}
+
+// a bundle can also be deployed from Charmhub:
+resource "juju_bundle" "kubeflow" {
+  model_uuid = juju_model.kubeflow.uuid
+  name       = "kubeflow"
+  channel    = "1.9/stable"
+}
@@ -0,0 +1,3 @@
+# JAAS roles can be imported using either the role name or its UUID.
+$ terraform import juju_jaas_role.development devops-team
+$ terraform import juju_jaas_role.development 2b6ca2a2-3c53-4a6d-9bfa-a8ea16d1f75e
@@ -0,0 +1,3 @@
+# JAAS service accounts can be imported using their ID
+# (normally referred to as a client ID).
+$ terraform import juju_jaas_service_account.automation ClientID
@@ -0,0 +1,18 @@
+resource "juju_credential" "aws" {
+  name = "automation"
+
+  cloud {
+    name = "aws"
+  }
+
+  auth_type = "access-key"
+  attributes = {
+    access-key = "ACCESS-KEY"
+    secret-key = "SECRET-KEY"
+  }
+}
+
+resource "juju_jaas_service_account" "automation" {
+  client_id   = "Client-ID"
+  credentials = [juju_credential.aws.id]
+}
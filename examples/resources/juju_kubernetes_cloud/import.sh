@@ -0,0 +1,3 @@
+# Kubernetes clouds can be imported using the cloud name. The kubernetes
+# config cannot be read back from the controller and must be set again.
+$ terraform import juju_kubernetes_cloud.my-k8s-cloud my-k8s-cloud
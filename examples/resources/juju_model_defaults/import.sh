@@ -0,0 +1,6 @@
+# The model defaults of a cloud can be imported by using the cloud name.
+$ terraform import juju_model_defaults.aws aws
+
+# The model defaults of a region can be imported by using the cloud name
+# and the region name.
+$ terraform import juju_model_defaults.aws_us_east_1 aws:us-east-1
@@ -0,0 +1,18 @@
+resource "juju_model_defaults" "aws" {
+  cloud = "aws"
+
+  config = {
+    http-proxy  = "http://proxy.example.com:3128"
+    https-proxy = "http://proxy.example.com:3128"
+    no-proxy    = "127.0.0.1,localhost,::1"
+  }
+}
+
+resource "juju_model_defaults" "aws_us_east_1" {
+  cloud  = "aws"
+  region = "us-east-1"
+
+  config = {
+    apt-mirror = "http://us-east-1.ec2.archive.ubuntu.com/ubuntu"
+  }
+}
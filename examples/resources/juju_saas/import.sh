@@ -0,0 +1,2 @@
+# Consumed offers can be imported by using the model UUID and the SAAS name.
+$ terraform import juju_saas.postgresql 4b6bd192-13ac-489b-8c62-b3eb8c42d5d8:postgresql
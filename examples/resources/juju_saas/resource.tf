@@ -0,0 +1,18 @@
+resource "juju_saas" "postgresql" {
+  model_uuid = juju_model.development.uuid
+  offer_url  = juju_offer.postgresql.url
+}
+
+resource "juju_integration" "discourse" {
+  model_uuid = juju_model.development.uuid
+
+  application {
+    name     = juju_application.discourse.name
+    endpoint = "database"
+  }
+
+  application {
+    name     = juju_saas.postgresql.name
+    endpoint = "database"
+  }
+}
@@ -5,7 +5,8 @@ resource "juju_secret" "my-secret" {
     key1 = "value1"
     key2 = "value2"
   }
-  info = "This is the secret"
+  info       = "This is the secret"
+  auto_prune = true
 }
 
 resource "juju_application" "my-application" {
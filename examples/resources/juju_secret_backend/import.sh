@@ -0,0 +1,2 @@
+# Secret backends can be imported by using their name.
+$ terraform import juju_secret_backend.vault myvault
@@ -0,0 +1,15 @@
+resource "juju_secret_backend" "vault" {
+  name                  = "myvault"
+  backend_type          = "vault"
+  token_rotate_interval = "48h"
+
+  config = {
+    endpoint = "https://vault.example.com:8200"
+    token    = var.vault_token
+  }
+}
+
+resource "juju_model" "development" {
+  name           = "development"
+  secret_backend = juju_secret_backend.vault.name
+}
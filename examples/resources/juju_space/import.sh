@@ -0,0 +1,2 @@
+# Spaces can be imported by using the model UUID and the space name.
+$ terraform import juju_space.db 4b6bd192-13ac-489b-8c62-b3eb8c42d5d8:db
@@ -0,0 +1,17 @@
+resource "juju_space" "db" {
+  model_uuid = juju_model.development.uuid
+  name       = "db"
+  subnets    = ["10.0.1.0/24"]
+}
+
+resource "juju_application" "postgresql" {
+  model_uuid = juju_model.development.uuid
+
+  charm {
+    name = "postgresql"
+  }
+
+  endpoint_bindings = [{
+    space = juju_space.db.name
+  }]
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apiaction "github.com/juju/juju/api/client/action"
+	"github.com/juju/names/v5"
+)
+
+// Action and operation statuses, as reported by Juju. Pending, running
+// and aborting actions are not done yet, while any final status other
+// than completed means the action did not succeed.
+const (
+	ActionStatusPending   = "pending"
+	ActionStatusRunning   = "running"
+	ActionStatusAborting  = "aborting"
+	ActionStatusCompleted = "completed"
+)
+
+type actionsClient struct {
+	SharedClient
+
+	getActionAPIClient func(api.Connection) ActionAPIClient
+}
+
+// RunActionInput holds the arguments needed to run a charm action.
+type RunActionInput struct {
+	ModelUUID       string
+	ApplicationName string
+	// Units are the names of the units to run the action on. The
+	// action runs on the leader of the application if none are given.
+	Units      []string
+	ActionName string
+	Parameters map[string]interface{}
+}
+
+// RunActionResponse holds the ID of the operation running the action.
+type RunActionResponse struct {
+	OperationID string
+}
+
+// ReadOperationInput holds the arguments needed to read an operation.
+type ReadOperationInput struct {
+	ModelUUID   string
+	OperationID string
+}
+
+// ActionTask is the result of an action run on a single unit.
+type ActionTask struct {
+	ID      string
+	Unit    string
+	Status  string
+	Message string
+	Output  map[string]interface{}
+}
+
+// ReadOperationResponse holds the status of an operation and of each
+// of its tasks.
+type ReadOperationResponse struct {
+	Status string
+	Tasks  []ActionTask
+}
+
+func newActionsClient(sc SharedClient) *actionsClient {
+	return &actionsClient{
+		SharedClient: sc,
+		getActionAPIClient: func(conn api.Connection) ActionAPIClient {
+			return apiaction.NewClient(conn)
+		},
+	}
+}
+
+// RunAction enqueues the action on the application's units and returns
+// the ID of the operation without waiting for it to complete.
+func (c *actionsClient) RunAction(input *RunActionInput) (*RunActionResponse, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	actionAPIClient := c.getActionAPIClient(conn)
+
+	specs, err := actionAPIClient.ApplicationCharmActions(input.ApplicationName)
+	if err != nil {
+		return nil, jujuerrors.Annotatef(err, "reading actions of application %q", input.ApplicationName)
+	}
+	if _, ok := specs[input.ActionName]; !ok {
+		return nil, jujuerrors.NotFoundf("action %q of application %q", input.ActionName, input.ApplicationName)
+	}
+
+	// The controller resolves the <application>/leader receiver
+	// to the current leader unit of the application.
+	receivers := []string{input.ApplicationName + "/leader"}
+	if len(input.Units) > 0 {
+		receivers = make([]string, 0, len(input.Units))
+		for _, unit := range input.Units {
+			if !names.IsValidUnit(unit) || !strings.HasPrefix(unit, input.ApplicationName+"/") {
+				return nil, jujuerrors.NotValidf("unit %q of application %q", unit, input.ApplicationName)
+			}
+			receivers = append(receivers, names.NewUnitTag(unit).String())
+		}
+	}
+
+	actions := make([]apiaction.Action, 0, len(receivers))
+	for _, receiver := range receivers {
+		actions = append(actions, apiaction.Action{
+			Receiver:   receiver,
+			Name:       input.ActionName,
+			Parameters: input.Parameters,
+		})
+	}
+	enqueued, err := actionAPIClient.EnqueueOperation(actions)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range enqueued.Actions {
+		if action.Error != nil {
+			return nil, jujuerrors.Annotatef(action.Error, "enqueuing action %q", input.ActionName)
+		}
+	}
+
+	return &RunActionResponse{OperationID: enqueued.OperationID}, nil
+}
+
+// ReadOperation returns the status of an operation and the results of
+// the actions it ran.
+func (c *actionsClient) ReadOperation(input *ReadOperationInput) (*ReadOperationResponse, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	actionAPIClient := c.getActionAPIClient(conn)
+
+	operation, err := actionAPIClient.Operation(input.OperationID)
+	if err != nil {
+		return nil, err
+	}
+	if operation.Error != nil {
+		return nil, operation.Error
+	}
+
+	response := &ReadOperationResponse{
+		Status: operation.Status,
+		Tasks:  make([]ActionTask, 0, len(operation.Actions)),
+	}
+	for _, result := range operation.Actions {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.Action == nil {
+			return nil, fmt.Errorf("no action returned for operation %q", input.OperationID)
+		}
+		unit := result.Action.Receiver
+		if tag, err := names.ParseUnitTag(unit); err == nil {
+			unit = tag.Id()
+		}
+		response.Tasks = append(response.Tasks, ActionTask{
+			ID:      result.Action.ID,
+			Unit:    unit,
+			Status:  result.Status,
+			Message: result.Message,
+			Output:  result.Output,
+		})
+	}
+	sort.Slice(response.Tasks, func(i, j int) bool {
+		return response.Tasks[i].Unit < response.Tasks[j].Unit
+	})
+	return response, nil
+}
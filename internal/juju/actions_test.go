@@ -0,0 +1,193 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apiaction "github.com/juju/juju/api/client/action"
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type ActionSuite struct {
+	suite.Suite
+	JujuSuite
+
+	mockActionClient *MockActionAPIClient
+}
+
+func (s *ActionSuite) SetupSuite() {
+	s.testModelName = strPtr("test-action-model")
+}
+
+func (s *ActionSuite) setupMocks(t *testing.T) *gomock.Controller {
+	ctlr := s.JujuSuite.setupMocks(t)
+	s.mockActionClient = NewMockActionAPIClient(ctlr)
+
+	return ctlr
+}
+
+func (s *ActionSuite) getActionsClient() actionsClient {
+	return actionsClient{
+		SharedClient: s.JujuSuite.mockSharedClient,
+		getActionAPIClient: func(api.Connection) ActionAPIClient {
+			return s.mockActionClient
+		},
+	}
+}
+
+func (s *ActionSuite) TestRunActionOnLeader() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().ApplicationCharmActions("postgresql").Return(map[string]apiaction.ActionSpec{
+		"get-password": {},
+	}, nil)
+	s.mockActionClient.EXPECT().EnqueueOperation([]apiaction.Action{{
+		Receiver:   "postgresql/leader",
+		Name:       "get-password",
+		Parameters: map[string]interface{}{"username": "operator"},
+	}}).Return(apiaction.EnqueuedActions{
+		OperationID: "1",
+		Actions:     []apiaction.ActionResult{{Action: &apiaction.Action{ID: "2"}}},
+	}, nil)
+
+	client := s.getActionsClient()
+	resp, err := client.RunAction(&RunActionInput{
+		ModelUUID:       *s.testModelName,
+		ApplicationName: "postgresql",
+		ActionName:      "get-password",
+		Parameters:      map[string]interface{}{"username": "operator"},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("1", resp.OperationID)
+}
+
+func (s *ActionSuite) TestRunActionOnUnits() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().ApplicationCharmActions("postgresql").Return(map[string]apiaction.ActionSpec{
+		"restart": {},
+	}, nil)
+	s.mockActionClient.EXPECT().EnqueueOperation([]apiaction.Action{{
+		Receiver: "unit-postgresql-0",
+		Name:     "restart",
+	}, {
+		Receiver: "unit-postgresql-1",
+		Name:     "restart",
+	}}).Return(apiaction.EnqueuedActions{OperationID: "3"}, nil)
+
+	client := s.getActionsClient()
+	resp, err := client.RunAction(&RunActionInput{
+		ModelUUID:       *s.testModelName,
+		ApplicationName: "postgresql",
+		Units:           []string{"postgresql/0", "postgresql/1"},
+		ActionName:      "restart",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("3", resp.OperationID)
+}
+
+func (s *ActionSuite) TestRunActionInvalidUnit() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().ApplicationCharmActions("postgresql").Return(map[string]apiaction.ActionSpec{
+		"restart": {},
+	}, nil)
+
+	client := s.getActionsClient()
+	_, err := client.RunAction(&RunActionInput{
+		ModelUUID:       *s.testModelName,
+		ApplicationName: "postgresql",
+		Units:           []string{"mysql/0"},
+		ActionName:      "restart",
+	})
+	s.Assert().True(errors.Is(err, errors.NotValid), err)
+}
+
+func (s *ActionSuite) TestRunActionUnknownAction() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().ApplicationCharmActions("postgresql").Return(map[string]apiaction.ActionSpec{
+		"restart": {},
+	}, nil)
+
+	client := s.getActionsClient()
+	_, err := client.RunAction(&RunActionInput{
+		ModelUUID:       *s.testModelName,
+		ApplicationName: "postgresql",
+		ActionName:      "get-password",
+	})
+	s.Assert().True(errors.Is(err, errors.NotFound), err)
+}
+
+func (s *ActionSuite) TestRunActionEnqueueError() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().ApplicationCharmActions("postgresql").Return(map[string]apiaction.ActionSpec{
+		"restart": {},
+	}, nil)
+	s.mockActionClient.EXPECT().EnqueueOperation(gomock.Any()).Return(apiaction.EnqueuedActions{
+		OperationID: "1",
+		Actions: []apiaction.ActionResult{{
+			Error: &params.Error{Message: `leader for application "postgresql" not found`},
+		}},
+	}, nil)
+
+	client := s.getActionsClient()
+	_, err := client.RunAction(&RunActionInput{
+		ModelUUID:       *s.testModelName,
+		ApplicationName: "postgresql",
+		ActionName:      "restart",
+	})
+	s.Assert().ErrorContains(err, `enqueuing action "restart": leader for application "postgresql" not found`)
+}
+
+func (s *ActionSuite) TestReadOperation() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().Operation("1").Return(apiaction.Operation{
+		ID:     "1",
+		Status: ActionStatusCompleted,
+		Actions: []apiaction.ActionResult{{
+			Action:  &apiaction.Action{ID: "3", Receiver: "unit-postgresql-1"},
+			Status:  ActionStatusCompleted,
+			Message: "done",
+		}, {
+			Action: &apiaction.Action{ID: "2", Receiver: "unit-postgresql-0"},
+			Status: ActionStatusCompleted,
+			Output: map[string]interface{}{"password": "secret"},
+		}},
+	}, nil)
+
+	client := s.getActionsClient()
+	resp, err := client.ReadOperation(&ReadOperationInput{
+		ModelUUID:   *s.testModelName,
+		OperationID: "1",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(&ReadOperationResponse{
+		Status: ActionStatusCompleted,
+		Tasks: []ActionTask{{
+			ID:     "2",
+			Unit:   "postgresql/0",
+			Status: ActionStatusCompleted,
+			Output: map[string]interface{}{"password": "secret"},
+		}, {
+			ID:      "3",
+			Unit:    "postgresql/1",
+			Status:  ActionStatusCompleted,
+			Message: "done",
+		}},
+	}, resp)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestActionSuite(t *testing.T) {
+	suite.Run(t, new(ActionSuite))
+}
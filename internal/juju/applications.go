@@ -105,6 +105,7 @@ type applicationsClient struct {
 	getClientAPIClient      func(api.Connection) ClientAPIClient
 	getModelConfigAPIClient func(api.Connection) ModelConfigAPIClient
 	getResourceAPIClient    func(connection api.Connection) (ResourceAPIClient, error)
+	getLocalCharmAPIClient  func(api.Connection) (LocalCharmAPIClient, error)
 }
 
 func newApplicationClient(sc SharedClient) *applicationsClient {
@@ -122,6 +123,9 @@ func newApplicationClient(sc SharedClient) *applicationsClient {
 		getResourceAPIClient: func(conn api.Connection) (ResourceAPIClient, error) {
 			return apiresources.NewClient(conn)
 		},
+		getLocalCharmAPIClient: func(conn api.Connection) (LocalCharmAPIClient, error) {
+			return apicharms.NewLocalCharmClient(conn)
+		},
 	}
 }
 
@@ -180,6 +184,10 @@ type CreateApplicationInput struct {
 	EndpointBindings   map[string]string
 	Resources          map[string]CharmResource
 	StorageConstraints map[string]jujustorage.Constraints
+	// CharmPath is the path to a local charm archive or directory.
+	// When set, the charm is uploaded to the controller rather than
+	// fetched from Charmhub and CharmName is ignored.
+	CharmPath string
 }
 
 // validateAndTransform returns transformedCreateApplicationInput which
@@ -189,6 +197,7 @@ type CreateApplicationInput struct {
 func (input CreateApplicationInput) validateAndTransform(conn api.Connection) (parsed transformedCreateApplicationInput, err error) {
 	parsed.charmChannel = input.CharmChannel
 	parsed.charmName = input.CharmName
+	parsed.charmPath = input.CharmPath
 	parsed.charmRevision = input.CharmRevision
 	parsed.constraints = input.Constraints
 	parsed.config = input.Config
@@ -202,6 +211,13 @@ func (input CreateApplicationInput) validateAndTransform(conn api.Connection) (p
 	if appName == "" {
 		appName = input.CharmName
 	}
+	if appName == "" && input.CharmPath != "" {
+		ch, _, err := readLocalCharm(input.CharmPath)
+		if err != nil {
+			return parsed, err
+		}
+		appName = ch.Meta().Name
+	}
 	if err = names.ValidateApplicationName(appName); err != nil {
 		return
 	}
@@ -279,6 +295,7 @@ func (input CreateApplicationInput) validateAndTransform(conn api.Connection) (p
 type transformedCreateApplicationInput struct {
 	applicationName  string
 	charmName        string
+	charmPath        string
 	charmChannel     string
 	charmBase        corebase.Base
 	charmRevision    int
@@ -323,6 +340,21 @@ type ReadApplicationResponse struct {
 	Resources        map[string]string
 }
 
+// UnitStatus holds the workload and agent status of a single unit.
+type UnitStatus struct {
+	Name            string
+	WorkloadStatus  string
+	WorkloadMessage string
+	AgentStatus     string
+	AgentMessage    string
+}
+
+// ReadApplicationStatusResponse holds the status of every unit of an
+// application, including the units of a subordinate application.
+type ReadApplicationStatusResponse struct {
+	Units []UnitStatus
+}
+
 type UpdateApplicationInput struct {
 	ModelUUID string
 	ModelInfo *params.ModelInfo
@@ -343,11 +375,23 @@ type UpdateApplicationInput struct {
 	Resources          map[string]CharmResource
 	AddMachines        []string
 	RemoveMachines     []string
+	// CharmPath is the path to a local charm archive or directory.
+	// When set, the local charm is uploaded and the application
+	// is refreshed to use it.
+	CharmPath string
 }
 
 type DestroyApplicationInput struct {
 	ApplicationName string
 	ModelUUID       string
+	// DestroyStorage controls whether the storage attached to the
+	// units of the application is destroyed or released. It defaults
+	// to true.
+	DestroyStorage *bool
+	// Force removes the application ignoring errors, MaxWait is how
+	// long each step of the forced removal waits before moving on.
+	Force   bool
+	MaxWait *time.Duration
 }
 
 func resolveCharmURL(charmName string) (*charm.URL, error) {
@@ -380,7 +424,21 @@ func (c applicationsClient) CreateApplication(ctx context.Context, input *Create
 	if err != nil {
 		return nil, err
 	}
-	if applicationAPIClient.BestAPIVersion() >= 19 {
+	if transformedInput.charmPath != "" {
+		localCharmAPIClient, err := c.getLocalCharmAPIClient(conn)
+		if err != nil {
+			return nil, err
+		}
+		modelConfigAPIClient := c.getModelConfigAPIClient(conn)
+		agentVersion, err := localCharmAgentVersion(conn, modelConfigAPIClient)
+		if err != nil {
+			return nil, err
+		}
+		err = c.deployLocalCharm(applicationAPIClient, localCharmAPIClient, modelConfigAPIClient, resourceAPIClient, agentVersion, transformedInput)
+		if err != nil {
+			return nil, jujuerrors.Annotate(err, "local charm deploy")
+		}
+	} else if applicationAPIClient.BestAPIVersion() >= 19 {
 		err := c.deployFromRepository(applicationAPIClient, resourceAPIClient, transformedInput)
 		if err != nil {
 			return nil, err
@@ -1205,6 +1263,107 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 	return response, nil
 }
 
+// ListApplications returns the names of the applications deployed in the
+// model, sorted by name. Remote applications are not included.
+func (c applicationsClient) ListApplications(modelUUID string) ([]string, error) {
+	conn, err := c.GetConnection(&modelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.ModelStatus(modelUUID, conn)
+	if err != nil {
+		return nil, errors.Annotatef(err, "fetching status of model %q", modelUUID)
+	}
+	appNames := make([]string, 0, len(status.Applications))
+	for name := range status.Applications {
+		appNames = append(appNames, name)
+	}
+	slices.Sort(appNames)
+	return appNames, nil
+}
+
+// ReadApplicationStatus returns the workload and agent status of the units
+// of an application. The status is read from the snapshot of the model if
+// it is being watched, or else from the cached model status.
+func (c applicationsClient) ReadApplicationStatus(input *ReadApplicationInput) (*ReadApplicationStatusResponse, error) {
+	if snapshot, ok := c.ModelSnapshot(input.ModelUUID); ok {
+		return applicationStatusFromSnapshot(snapshot, input.AppName)
+	}
+
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.ModelStatus(input.ModelUUID, conn)
+	if err != nil {
+		return nil, err
+	}
+	appStatus, ok := status.Applications[input.AppName]
+	if !ok {
+		return nil, NewApplicationNotFoundError(input.AppName)
+	}
+
+	toUnitStatus := func(name string, unit params.UnitStatus) UnitStatus {
+		return UnitStatus{
+			Name:            name,
+			WorkloadStatus:  unit.WorkloadStatus.Status,
+			WorkloadMessage: unit.WorkloadStatus.Info,
+			AgentStatus:     unit.AgentStatus.Status,
+			AgentMessage:    unit.AgentStatus.Info,
+		}
+	}
+
+	response := &ReadApplicationStatusResponse{}
+	for name, unit := range appStatus.Units {
+		response.Units = append(response.Units, toUnitStatus(name, unit))
+	}
+	// Units of a subordinate application are only found
+	// nested in the units of their principal applications.
+	for _, principal := range appStatus.SubordinateTo {
+		for _, principalUnit := range status.Applications[principal].Units {
+			for name, unit := range principalUnit.Subordinates {
+				if strings.HasPrefix(name, input.AppName+"/") {
+					response.Units = append(response.Units, toUnitStatus(name, unit))
+				}
+			}
+		}
+	}
+	slices.SortFunc(response.Units, func(a, b UnitStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return response, nil
+}
+
+// applicationStatusFromSnapshot returns the status of the units of an
+// application from the snapshot of its model. Units of a subordinate
+// application are units of that application in the snapshot too.
+func applicationStatusFromSnapshot(snapshot *ModelSnapshot, appName string) (*ReadApplicationStatusResponse, error) {
+	if _, ok := snapshot.Applications[appName]; !ok {
+		return nil, NewApplicationNotFoundError(appName)
+	}
+	response := &ReadApplicationStatusResponse{}
+	for name, unit := range snapshot.Units {
+		if unit.Application != appName {
+			continue
+		}
+		response.Units = append(response.Units, UnitStatus{
+			Name:            name,
+			WorkloadStatus:  unit.WorkloadStatus.Current.String(),
+			WorkloadMessage: unit.WorkloadStatus.Message,
+			AgentStatus:     unit.AgentStatus.Current.String(),
+			AgentMessage:    unit.AgentStatus.Message,
+		})
+	}
+	slices.SortFunc(response.Units, func(a, b UnitStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return response, nil
+}
+
 // removeDefaultCidrs is an auxiliar function to remove
 // the "0.0.0.0/0 and ::/0" strings from an array of
 // cidrs
@@ -1262,6 +1421,21 @@ func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) err
 		auxConfig["trust"] = fmt.Sprintf("%v", *input.Trust)
 	}
 
+	if input.CharmPath != "" {
+		localCharmAPIClient, err := c.getLocalCharmAPIClient(conn)
+		if err != nil {
+			return err
+		}
+		agentVersion, err := localCharmAgentVersion(conn, modelconfigAPIClient)
+		if err != nil {
+			return err
+		}
+		if err := c.refreshLocalCharm(input, applicationAPIClient, localCharmAPIClient, agentVersion); err != nil {
+			c.Errorf(err, "refreshing local charm")
+			return err
+		}
+	}
+
 	err = c.UpdateCharmAndResources(input, applicationAPIClient, charmsAPIClient, resourcesAPIClient)
 	if err != nil {
 		c.Errorf(err, "updating charm and resources")
@@ -1514,11 +1688,18 @@ func (c applicationsClient) DestroyApplication(input *DestroyApplicationInput) e
 
 	applicationAPIClient := apiapplication.NewClient(conn)
 
+	destroyStorage := true
+	if input.DestroyStorage != nil {
+		destroyStorage = *input.DestroyStorage
+	}
+
 	var destroyParams = apiapplication.DestroyApplicationsParams{
 		Applications: []string{
 			input.ApplicationName,
 		},
-		DestroyStorage: true,
+		DestroyStorage: destroyStorage,
+		Force:          input.Force,
+		MaxWait:        input.MaxWait,
 	}
 
 	_, err = applicationAPIClient.DestroyApplications(destroyParams)
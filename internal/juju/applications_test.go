@@ -8,8 +8,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/juju/charm/v12"
 	charmresources "github.com/juju/charm/v12/resource"
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/api/base"
@@ -43,6 +46,7 @@ type ApplicationSuite struct {
 	mockResourceAPIClient *MockResourceAPIClient
 	mockConnection        *MockConnection
 	mockModelConfigClient *MockModelConfigAPIClient
+	mockLocalCharmClient  *MockLocalCharmAPIClient
 	mockSharedClient      *MockSharedClient
 	mockCharmhubClient    *MockCharmhubClient
 }
@@ -82,6 +86,8 @@ func (s *ApplicationSuite) setupMocks(t *testing.T) *gomock.Controller {
 	attrs["default-space"] = "alpha"
 	s.mockModelConfigClient.EXPECT().ModelGet().Return(attrs, nil).AnyTimes()
 
+	s.mockLocalCharmClient = NewMockLocalCharmAPIClient(ctlr)
+
 	log := func(msg string, additionalFields ...map[string]interface{}) {
 		s.T().Logf("logging from shared client %q, %+v", msg, additionalFields)
 	}
@@ -115,6 +121,9 @@ func (s *ApplicationSuite) getApplicationsClient() applicationsClient {
 		getResourceAPIClient: func(_ api.Connection) (ResourceAPIClient, error) {
 			return s.mockResourceAPIClient, nil
 		},
+		getLocalCharmAPIClient: func(_ api.Connection) (LocalCharmAPIClient, error) {
+			return s.mockLocalCharmClient, nil
+		},
 	}
 }
 
@@ -911,6 +920,339 @@ maintainers:
 	}
 }
 
+// writeLocalCharm writes a minimal charm directory, with the given
+// extra metadata, to a temporary directory and returns its path.
+func (s *ApplicationSuite) writeLocalCharm(extraMetadata string) string {
+	dir := s.T().TempDir()
+	files := map[string]string{
+		"metadata.yaml": "name: test-charm\nsummary: test\ndescription: test\n" + extraMetadata,
+		"manifest.yaml": "bases:\n  - name: ubuntu\n    channel: \"22.04\"\n    architectures: [amd64]\n",
+		"dispatch":      "#!/bin/sh\n",
+	}
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0755)
+		s.Require().NoError(err)
+	}
+	return dir
+}
+
+func (s *ApplicationSuite) TestLocalCharmHash() {
+	charmDir := s.writeLocalCharm("")
+
+	dirHash, err := LocalCharmHash(charmDir)
+	s.Require().NoError(err)
+	sameDirHash, err := LocalCharmHash(charmDir)
+	s.Require().NoError(err)
+	s.Assert().Equal(dirHash, sameDirHash, "hash of an unchanged charm directory must be stable")
+
+	// Pack the charm into an archive and check it hashes too.
+	ch, err := charm.ReadCharmDir(charmDir)
+	s.Require().NoError(err)
+	archivePath := filepath.Join(s.T().TempDir(), "test-charm.charm")
+	archive, err := os.Create(archivePath)
+	s.Require().NoError(err)
+	s.Require().NoError(ch.ArchiveTo(archive))
+	s.Require().NoError(archive.Close())
+	archiveHash, err := LocalCharmHash(archivePath)
+	s.Require().NoError(err)
+	s.Assert().Len(archiveHash, 64)
+
+	// Rebuilding the charm must change the hash.
+	err = os.WriteFile(filepath.Join(charmDir, "dispatch"), []byte("#!/bin/sh\necho rebuilt\n"), 0755)
+	s.Require().NoError(err)
+	rebuiltHash, err := LocalCharmHash(charmDir)
+	s.Require().NoError(err)
+	s.Assert().NotEqual(dirHash, rebuiltHash, "a rebuilt charm must have a different hash")
+
+	_, err = LocalCharmHash(filepath.Join(charmDir, "does-not-exist"))
+	s.Assert().Error(err)
+}
+
+func (s *ApplicationSuite) TestLocalCharmAgentVersion() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	s.mockConnection.EXPECT().ServerVersion().Return(version.MustParse("3.6.4"), true)
+	agentVersion, err := localCharmAgentVersion(s.mockConnection, s.mockModelConfigClient)
+	s.Require().NoError(err)
+	s.Assert().Equal(version.MustParse("3.6.4"), agentVersion)
+
+	// Without the version of the controller, the agent version of the
+	// model is used.
+	cfg, err := config.New(true, map[string]interface{}{
+		"name":            "test",
+		"type":            "manual",
+		"uuid":            utils.MustNewUUID().String(),
+		"controller-uuid": utils.MustNewUUID().String(),
+		"agent-version":   "3.6.2",
+	})
+	s.Require().NoError(err)
+	modelConfigClient := NewMockModelConfigAPIClient(ctlr)
+	modelConfigClient.EXPECT().ModelGet().Return(cfg.AllAttrs(), nil)
+	s.mockConnection.EXPECT().ServerVersion().Return(version.Zero, false).Times(2)
+	agentVersion, err = localCharmAgentVersion(s.mockConnection, modelConfigClient)
+	s.Require().NoError(err)
+	s.Assert().Equal(version.MustParse("3.6.2"), agentVersion)
+
+	// The model config of the suite has no agent version.
+	_, err = localCharmAgentVersion(s.mockConnection, s.mockModelConfigClient)
+	s.Assert().ErrorContains(err, "cannot determine the agent version of the model")
+}
+
+func (s *ApplicationSuite) TestDeployLocalCharm() {
+	defer s.setupMocks(s.T()).Finish()
+	client := s.getApplicationsClient()
+	charmDir := s.writeLocalCharm("")
+
+	s.mockModelConfigClient.EXPECT().GetModelConstraints().Return(constraints.Value{}, nil)
+	s.mockLocalCharmClient.EXPECT().AddLocalCharm(gomock.Any(), gomock.Any(), false, gomock.Any()).DoAndReturn(
+		func(curl *charm.URL, ch charm.Charm, _ bool, _ version.Number) (*charm.URL, error) {
+			s.Assert().Equal("local:test-charm-0", curl.String())
+			s.Assert().Equal("test-charm", ch.Meta().Name)
+			return charm.MustParseURL("local:test-charm-3"), nil
+		})
+	s.mockApplicationClient.EXPECT().Deploy(gomock.Any()).DoAndReturn(
+		func(args apiapplication.DeployArgs) error {
+			s.Assert().Equal("local:test-charm-3", args.CharmID.URL)
+			s.Assert().Equal(apicharm.OriginLocal, args.CharmOrigin.Source)
+			s.Require().NotNil(args.CharmOrigin.Revision)
+			s.Assert().Equal(3, *args.CharmOrigin.Revision)
+			s.Assert().Equal("ubuntu@22.04/stable", args.CharmOrigin.Base.String())
+			s.Assert().Equal("my-app", args.ApplicationName)
+			s.Assert().Equal(2, args.NumUnits)
+			s.Assert().Equal("true", args.Config["trust"])
+			return nil
+		})
+
+	err := client.deployLocalCharm(s.mockApplicationClient, s.mockLocalCharmClient, s.mockModelConfigClient, s.mockResourceAPIClient,
+		version.MustParse("3.6.4"), transformedCreateApplicationInput{
+			applicationName: "my-app",
+			charmPath:       charmDir,
+			units:           2,
+			trust:           true,
+		})
+	s.Assert().NoError(err)
+}
+
+func (s *ApplicationSuite) TestDeployLocalCharmUnsupportedBase() {
+	defer s.setupMocks(s.T()).Finish()
+	client := s.getApplicationsClient()
+	charmDir := s.writeLocalCharm("")
+
+	err := client.deployLocalCharm(s.mockApplicationClient, s.mockLocalCharmClient, s.mockModelConfigClient, s.mockResourceAPIClient,
+		version.MustParse("3.6.4"), transformedCreateApplicationInput{
+			applicationName: "my-app",
+			charmPath:       charmDir,
+			charmBase:       corebase.MustParseBaseFromString("ubuntu@20.04"),
+		})
+	s.Assert().ErrorContains(err, `base "ubuntu@20.04/stable" is not supported by the local charm "test-charm"`)
+}
+
+func (s *ApplicationSuite) TestDeployLocalCharmMissingResource() {
+	defer s.setupMocks(s.T()).Finish()
+	client := s.getApplicationsClient()
+	charmDir := s.writeLocalCharm("resources:\n  app-image:\n    type: oci-image\n")
+
+	err := client.deployLocalCharm(s.mockApplicationClient, s.mockLocalCharmClient, s.mockModelConfigClient, s.mockResourceAPIClient,
+		version.MustParse("3.6.4"), transformedCreateApplicationInput{
+			applicationName: "my-app",
+			charmPath:       charmDir,
+			resources:       map[string]CharmResource{"app-image": {RevisionNumber: "4"}},
+		})
+	s.Assert().ErrorContains(err, `local charms require resource "app-image" to be specified as an OCI image`)
+}
+
+func (s *ApplicationSuite) TestRefreshLocalCharm() {
+	defer s.setupMocks(s.T()).Finish()
+	client := s.getApplicationsClient()
+	charmDir := s.writeLocalCharm("")
+	appName := "my-app"
+
+	revision := 3
+	s.mockApplicationClient.EXPECT().GetCharmURLOrigin("", appName).Return(
+		charm.MustParseURL("local:test-charm-3"),
+		apicharm.Origin{
+			Source:       apicharm.OriginLocal,
+			Type:         "charm",
+			Revision:     &revision,
+			Base:         corebase.MustParseBaseFromString("ubuntu@22.04"),
+			Architecture: "amd64",
+		}, nil)
+	s.mockLocalCharmClient.EXPECT().AddLocalCharm(gomock.Any(), gomock.Any(), false, gomock.Any()).Return(
+		charm.MustParseURL("local:test-charm-4"), nil)
+	s.mockApplicationClient.EXPECT().SetCharm(model.GenerationMaster, gomock.Any()).DoAndReturn(
+		func(_ string, cfg apiapplication.SetCharmConfig) error {
+			s.Assert().Equal(appName, cfg.ApplicationName)
+			s.Assert().Equal("local:test-charm-4", cfg.CharmID.URL)
+			s.Assert().Equal(apicharm.OriginLocal, cfg.CharmID.Origin.Source)
+			s.Require().NotNil(cfg.CharmID.Origin.Revision)
+			s.Assert().Equal(4, *cfg.CharmID.Origin.Revision)
+			s.Assert().Equal("amd64", cfg.CharmID.Origin.Architecture)
+			return nil
+		})
+
+	err := client.refreshLocalCharm(&UpdateApplicationInput{
+		ModelUUID: s.testModelUUID,
+		AppName:   appName,
+		CharmPath: charmDir,
+	}, s.mockApplicationClient, s.mockLocalCharmClient, version.MustParse("3.6.4"))
+	s.Assert().NoError(err)
+}
+
+func (s *ApplicationSuite) TestRefreshLocalCharmUnsupportedBase() {
+	defer s.setupMocks(s.T()).Finish()
+	client := s.getApplicationsClient()
+	charmDir := s.writeLocalCharm("")
+	appName := "my-app"
+
+	s.mockApplicationClient.EXPECT().GetCharmURLOrigin("", appName).Return(
+		charm.MustParseURL("local:test-charm-3"),
+		apicharm.Origin{
+			Source: apicharm.OriginLocal,
+			Base:   corebase.MustParseBaseFromString("ubuntu@20.04"),
+		}, nil)
+
+	err := client.refreshLocalCharm(&UpdateApplicationInput{
+		ModelUUID: s.testModelUUID,
+		AppName:   appName,
+		CharmPath: charmDir,
+	}, s.mockApplicationClient, s.mockLocalCharmClient, version.MustParse("3.6.4"))
+	s.Assert().ErrorContains(err, "the new local charm does not support the current operating system")
+}
+
+func (s *ApplicationSuite) TestReadApplicationStatus() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockSharedClient.EXPECT().ModelSnapshot(s.testModelUUID).Return(nil, false).Times(2)
+	s.mockSharedClient.EXPECT().ModelStatus(s.testModelUUID, s.mockConnection).Return(&params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{
+			"principal": {
+				Units: map[string]params.UnitStatus{
+					"principal/1": {
+						WorkloadStatus: params.DetailedStatus{Status: "blocked", Info: "missing relation"},
+						AgentStatus:    params.DetailedStatus{Status: "idle"},
+						Subordinates: map[string]params.UnitStatus{
+							"subordinate/0": {
+								WorkloadStatus: params.DetailedStatus{Status: "active"},
+								AgentStatus:    params.DetailedStatus{Status: "executing", Info: "running install hook"},
+							},
+						},
+					},
+					"principal/0": {
+						WorkloadStatus: params.DetailedStatus{Status: "active"},
+						AgentStatus:    params.DetailedStatus{Status: "idle"},
+					},
+				},
+			},
+			"subordinate": {
+				SubordinateTo: []string{"principal"},
+			},
+		},
+	}, nil).Times(2)
+	client := s.getApplicationsClient()
+
+	resp, err := client.ReadApplicationStatus(&ReadApplicationInput{
+		ModelUUID: s.testModelUUID,
+		AppName:   "principal",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]UnitStatus{{
+		Name:           "principal/0",
+		WorkloadStatus: "active",
+		AgentStatus:    "idle",
+	}, {
+		Name:            "principal/1",
+		WorkloadStatus:  "blocked",
+		WorkloadMessage: "missing relation",
+		AgentStatus:     "idle",
+	}}, resp.Units)
+
+	resp, err = client.ReadApplicationStatus(&ReadApplicationInput{
+		ModelUUID: s.testModelUUID,
+		AppName:   "subordinate",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]UnitStatus{{
+		Name:           "subordinate/0",
+		WorkloadStatus: "active",
+		AgentStatus:    "executing",
+		AgentMessage:   "running install hook",
+	}}, resp.Units)
+}
+
+func (s *ApplicationSuite) TestReadApplicationStatusNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockSharedClient.EXPECT().ModelSnapshot(s.testModelUUID).Return(nil, false)
+	s.mockSharedClient.EXPECT().ModelStatus(s.testModelUUID, s.mockConnection).Return(&params.FullStatus{}, nil)
+	client := s.getApplicationsClient()
+
+	_, err := client.ReadApplicationStatus(&ReadApplicationInput{
+		ModelUUID: s.testModelUUID,
+		AppName:   "missing",
+	})
+	s.Assert().ErrorIs(err, ApplicationNotFoundError)
+}
+
+func (s *ApplicationSuite) TestReadApplicationStatusFromSnapshot() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockSharedClient.EXPECT().ModelSnapshot(s.testModelUUID).Return(&ModelSnapshot{
+		Applications: map[string]*params.ApplicationInfo{
+			"principal":   {Name: "principal"},
+			"subordinate": {Name: "subordinate", Subordinate: true},
+		},
+		Units: map[string]*params.UnitInfo{
+			"principal/0": {
+				Name:           "principal/0",
+				Application:    "principal",
+				WorkloadStatus: params.StatusInfo{Current: "blocked", Message: "missing relation"},
+				AgentStatus:    params.StatusInfo{Current: "idle"},
+			},
+			"subordinate/0": {
+				Name:           "subordinate/0",
+				Application:    "subordinate",
+				Principal:      "principal/0",
+				Subordinate:    true,
+				WorkloadStatus: params.StatusInfo{Current: "active"},
+				AgentStatus:    params.StatusInfo{Current: "executing", Message: "running install hook"},
+			},
+		},
+	}, true).Times(2)
+	client := s.getApplicationsClient()
+
+	resp, err := client.ReadApplicationStatus(&ReadApplicationInput{
+		ModelUUID: s.testModelUUID,
+		AppName:   "subordinate",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]UnitStatus{{
+		Name:           "subordinate/0",
+		WorkloadStatus: "active",
+		AgentStatus:    "executing",
+		AgentMessage:   "running install hook",
+	}}, resp.Units)
+
+	_, err = client.ReadApplicationStatus(&ReadApplicationInput{
+		ModelUUID: s.testModelUUID,
+		AppName:   "missing",
+	})
+	s.Assert().ErrorIs(err, ApplicationNotFoundError)
+}
+
+func (s *ApplicationSuite) TestListApplications() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockSharedClient.EXPECT().ModelStatus(s.testModelUUID, s.mockConnection).Return(&params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{
+			"wordpress": {},
+			"mysql":     {},
+			"nrpe":      {SubordinateTo: []string{"wordpress"}},
+		},
+	}, nil)
+	client := s.getApplicationsClient()
+
+	appNames, err := client.ListApplications(s.testModelUUID)
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"mysql", "nrpe", "wordpress"}, appNames)
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestApplicationSuite(t *testing.T) {
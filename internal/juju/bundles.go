@@ -0,0 +1,969 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/charm/v12"
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apiannotations "github.com/juju/juju/api/client/annotations"
+	apiapplication "github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/api/client/applicationoffers"
+	apiclient "github.com/juju/juju/api/client/client"
+	apimachinemanager "github.com/juju/juju/api/client/machinemanager"
+	apimodelconfig "github.com/juju/juju/api/client/modelconfig"
+	"github.com/juju/juju/charmhub"
+	"github.com/juju/juju/charmhub/transport"
+	"github.com/juju/juju/cmd/juju/application/bundle"
+	"github.com/juju/juju/cmd/juju/application/utils"
+	corebase "github.com/juju/juju/core/base"
+	bundlechanges "github.com/juju/juju/core/bundle/changes"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/devices"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+	jujustorage "github.com/juju/juju/storage"
+	"github.com/juju/names/v5"
+	goyaml "gopkg.in/yaml.v2"
+)
+
+const (
+	// BundleUnitMachineTimeout is the time to wait for a unit deployed
+	// by a bundle to be assigned to a machine, when the machine is
+	// needed to place another unit or container.
+	BundleUnitMachineTimeout = 5 * time.Minute
+	// BundleApiTickWait is the time to wait between consecutive requests
+	// to the API while waiting for a unit to be assigned to a machine.
+	BundleApiTickWait = time.Second * 5
+)
+
+type bundlesClient struct {
+	SharedClient
+
+	getAnnotationsAPIClient       func(api.Connection) AnnotationsAPIClient
+	getApplicationAPIClient       func(api.Connection) ApplicationAPIClient
+	getApplicationOffersAPIClient func(api.Connection) ApplicationOffersAPIClient
+	getClientAPIClient            func(api.Connection) ClientAPIClient
+	getMachineManagerAPIClient    func(api.Connection) MachineManagerAPIClient
+	getModelConfigAPIClient       func(api.Connection) ModelConfigAPIClient
+	// updateApplication is used to refresh the charm of applications
+	// already deployed in the model.
+	updateApplication func(*UpdateApplicationInput) error
+}
+
+// ApplyBundleInput represents input for deploying a bundle, or for
+// updating the model to match a bundle.
+type ApplyBundleInput struct {
+	ModelUUID string
+	// Bundle holds an inline bundle in YAML format. Exactly one of
+	// Bundle and CharmhubBundle must be set.
+	Bundle string
+	// CharmhubBundle is the name of a bundle to fetch from Charmhub.
+	CharmhubBundle string
+	// Channel is the channel to fetch CharmhubBundle from.
+	Channel string
+	// Overlays hold bundle overlays in YAML format, applied in order.
+	Overlays []string
+	// OfferOwner is the owner of the offers created by the bundle.
+	OfferOwner string
+}
+
+// ApplyBundleResponse represents the entities of the model managed by
+// a bundle once applied.
+type ApplyBundleResponse struct {
+	Applications []string
+	Integrations []string
+	// Machines holds the IDs of the machines added to the model while
+	// applying the bundle.
+	Machines []string
+	Offers   []string
+}
+
+// ReadBundleInput represents input for reading the entities of the model
+// previously created by a bundle.
+type ReadBundleInput struct {
+	ModelUUID    string
+	Applications []string
+	Machines     []string
+	Offers       []string
+}
+
+// ReadBundleResponse represents the entities created by a bundle which
+// still exist in the model.
+type ReadBundleResponse struct {
+	Applications []string
+	Integrations []string
+	Machines     []string
+	Offers       []string
+}
+
+// DestroyBundleInput represents input for removing the applications and
+// offers created by a bundle.
+type DestroyBundleInput struct {
+	ModelUUID    string
+	Applications []string
+	Offers       []string
+}
+
+func newBundlesClient(sc SharedClient, applications *applicationsClient) *bundlesClient {
+	return &bundlesClient{
+		SharedClient: sc,
+		getAnnotationsAPIClient: func(conn api.Connection) AnnotationsAPIClient {
+			return apiannotations.NewClient(conn)
+		},
+		getApplicationAPIClient: func(conn api.Connection) ApplicationAPIClient {
+			return apiapplication.NewClient(conn)
+		},
+		getApplicationOffersAPIClient: func(conn api.Connection) ApplicationOffersAPIClient {
+			return applicationoffers.NewClient(conn)
+		},
+		getClientAPIClient: func(conn api.Connection) ClientAPIClient {
+			return apiclient.NewClient(conn, sc.JujuLogger())
+		},
+		getMachineManagerAPIClient: func(conn api.Connection) MachineManagerAPIClient {
+			return apimachinemanager.NewClient(conn)
+		},
+		getModelConfigAPIClient: func(conn api.Connection) ModelConfigAPIClient {
+			return apimodelconfig.NewClient(conn)
+		},
+		updateApplication: applications.UpdateApplication,
+	}
+}
+
+// readBundleData returns the bundle described by the input, with all
+// overlays merged in and verified.
+func (c bundlesClient) readBundleData(ctx context.Context, input *ApplyBundleInput) (*charm.BundleData, error) {
+	bundleYAML := input.Bundle
+	if input.CharmhubBundle != "" {
+		charmhubClient, err := newCharmhubClient()
+		if err != nil {
+			return nil, jujuerrors.Trace(err)
+		}
+		bundleYAML, err = charmhubBundleYAML(ctx, charmhubClient, input.CharmhubBundle, input.Channel)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return mergeBundleData(bundleYAML, input.Overlays)
+}
+
+// charmhubBundleYAML fetches the bundle YAML of the named bundle from
+// Charmhub, for the given channel or the default one if empty.
+func charmhubBundleYAML(ctx context.Context, client CharmhubClient, name, channel string) (string, error) {
+	var options []charmhub.InfoOption
+	if channel != "" {
+		options = append(options, charmhub.WithInfoChannel(channel))
+	}
+	info, err := client.Info(ctx, name, options...)
+	if err != nil {
+		return "", jujuerrors.Annotatef(err, "fetching bundle %q from Charmhub", name)
+	}
+	if info.Type != transport.BundleType {
+		return "", jujuerrors.NotValidf("%q is a %s, not a bundle", name, info.Type)
+	}
+	if info.DefaultRelease.Revision.BundleYAML == "" {
+		return "", jujuerrors.NotFoundf("bundle %q in channel %q", name, channel)
+	}
+	return info.DefaultRelease.Revision.BundleYAML, nil
+}
+
+// mergeBundleData reads the base bundle and the overlays, merges them
+// in order and verifies the result.
+func mergeBundleData(bundleYAML string, overlays []string) (*charm.BundleData, error) {
+	sources := make([]charm.BundleDataSource, 0, len(overlays)+1)
+	for i, part := range append([]string{bundleYAML}, overlays...) {
+		src, err := charm.StreamBundleDataSource(strings.NewReader(part), "")
+		if err != nil {
+			if i == 0 {
+				return nil, jujuerrors.Annotate(err, "reading bundle")
+			}
+			return nil, jujuerrors.Annotatef(err, "reading overlay %d", i)
+		}
+		sources = append(sources, src)
+	}
+	data, err := charm.ReadAndMergeBundleData(sources...)
+	if err != nil {
+		return nil, jujuerrors.Annotate(err, "merging bundle overlays")
+	}
+	err = data.Verify(
+		func(s string) error {
+			_, err := constraints.Parse(s)
+			return err
+		},
+		func(s string) error {
+			_, err := jujustorage.ParseConstraints(s)
+			return err
+		},
+		func(s string) error {
+			_, err := devices.ParseConstraints(s)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, jujuerrors.Annotate(err, "verifying bundle")
+	}
+	return data, nil
+}
+
+// ApplyBundle computes the changes required for the model to match the
+// bundle, based on the current status of the model, and applies them in
+// dependency order.
+func (c bundlesClient) ApplyBundle(ctx context.Context, input *ApplyBundleInput) (*ApplyBundleResponse, error) {
+	data, err := c.readBundleData(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	applier := &bundleApplier{
+		bundlesClient: c,
+		ctx:           ctx,
+		modelUUID:     input.ModelUUID,
+		offerOwner:    input.OfferOwner,
+		data:          data,
+		application:   c.getApplicationAPIClient(conn),
+		annotations:   c.getAnnotationsAPIClient(conn),
+		client:        c.getClientAPIClient(conn),
+		machines:      c.getMachineManagerAPIClient(conn),
+		charms:        make(map[string]bundlechanges.AddCharmParams),
+		results:       make(map[string]string),
+	}
+	if bundleHasOffers(data) {
+		controllerConn, err := c.GetConnection(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = controllerConn.Close() }()
+		applier.offers = c.getApplicationOffersAPIClient(controllerConn)
+	}
+
+	status, err := c.ModelStatus(input.ModelUUID, conn)
+	if err != nil {
+		return nil, err
+	}
+	modelConfigAPIClient := c.getModelConfigAPIClient(conn)
+	modelRepresentation, err := bundle.BuildModelRepresentation(status, bundleModelExtractor{
+		ApplicationAPIClient: applier.application,
+		annotations:          applier.annotations,
+		modelConfig:          modelConfigAPIClient,
+	}, false, nil)
+	if err != nil {
+		return nil, jujuerrors.Annotate(err, "reading model")
+	}
+	modelConstraints, err := modelConfigAPIClient.GetModelConstraints()
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := bundlechanges.FromData(bundlechanges.ChangesConfig{
+		Bundle:           data,
+		Model:            modelRepresentation,
+		Logger:           bundleChangesLogger{SharedClient: c.SharedClient},
+		ConstraintGetter: bundleConstraintsParser(modelConstraints),
+	})
+	if err != nil {
+		return nil, jujuerrors.Annotate(err, "computing bundle changes")
+	}
+
+	// Refuse the whole bundle up front rather than leaving the
+	// model half deployed.
+	for _, change := range changes {
+		if !bundleChangeSupported(change) {
+			return nil, jujuerrors.NotSupportedf("bundle change %q", change.Method())
+		}
+	}
+	for _, change := range changes {
+		c.Tracef("Applying bundle change", map[string]interface{}{"id": change.Id(), "change": change.Description()})
+		if err := applier.apply(change); err != nil {
+			return nil, jujuerrors.Annotatef(err, "applying bundle change %q", strings.Join(change.Description(), ", "))
+		}
+	}
+
+	modelOwner, modelName, err := c.ModelOwnerAndName(input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	applications := make([]string, 0, len(data.Applications))
+	var offers []string
+	for name, app := range data.Applications {
+		applications = append(applications, name)
+		for offerName := range app.Offers {
+			offers = append(offers, offerURL(modelOwner, modelName, offerName))
+		}
+	}
+	sort.Strings(applications)
+	sort.Strings(offers)
+
+	// Read the status directly, the cached model status predates the
+	// changes.
+	status, err = applier.client.Status(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplyBundleResponse{
+		Applications: applications,
+		Integrations: bundleIntegrations(status, applications),
+		Machines:     applier.createdMachines,
+		Offers:       offers,
+	}, nil
+}
+
+// ReadBundle returns the entities created by a bundle which still exist
+// in the model.
+func (c bundlesClient) ReadBundle(input *ReadBundleInput) (*ReadBundleResponse, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.ModelStatus(input.ModelUUID, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadBundleResponse{}
+	for _, app := range input.Applications {
+		if _, ok := status.Applications[app]; ok {
+			response.Applications = append(response.Applications, app)
+		}
+	}
+	for _, machine := range input.Machines {
+		if _, ok := status.Machines[machine]; ok {
+			response.Machines = append(response.Machines, machine)
+		}
+	}
+	for _, url := range input.Offers {
+		parsed, err := charm.ParseOfferURL(url)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := status.Offers[parsed.ApplicationName]; ok {
+			response.Offers = append(response.Offers, url)
+		}
+	}
+	response.Integrations = bundleIntegrations(status, response.Applications)
+	return response, nil
+}
+
+// DestroyBundle removes the offers and the applications created by a
+// bundle. Machines created by the bundle are left for the caller to
+// remove once the applications are gone.
+func (c bundlesClient) DestroyBundle(input *DestroyBundleInput) error {
+	if len(input.Offers) > 0 {
+		controllerConn, err := c.GetConnection(nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = controllerConn.Close() }()
+		if err := c.getApplicationOffersAPIClient(controllerConn).DestroyOffers(false, input.Offers...); err != nil {
+			return jujuerrors.Annotate(err, "destroying offers")
+		}
+	}
+	if len(input.Applications) == 0 {
+		return nil
+	}
+
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	results, err := c.getApplicationAPIClient(conn).DestroyApplications(apiapplication.DestroyApplicationsParams{
+		Applications: input.Applications,
+	})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, result := range results {
+		if result.Error != nil && !params.IsCodeNotFound(result.Error) {
+			errs = append(errs, result.Error)
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
+// bundleApplier applies the changes computed for a bundle, keeping track
+// of the entities created by each change so that later changes can refer
+// to them.
+type bundleApplier struct {
+	bundlesClient
+
+	ctx        context.Context
+	modelUUID  string
+	offerOwner string
+	data       *charm.BundleData
+
+	application ApplicationAPIClient
+	annotations AnnotationsAPIClient
+	client      ClientAPIClient
+	machines    MachineManagerAPIClient
+	offers      ApplicationOffersAPIClient
+
+	// charms maps the ID of addCharm changes to their parameters.
+	charms map[string]bundlechanges.AddCharmParams
+	// results maps the ID of a change to the name of the entity
+	// it created: an application, a machine or a unit.
+	results         map[string]string
+	createdMachines []string
+}
+
+func (a *bundleApplier) apply(change bundlechanges.Change) error {
+	switch change := change.(type) {
+	case *bundlechanges.AddCharmChange:
+		// Charms are added to the model when their application
+		// is deployed.
+		a.charms[change.Id()] = change.Params
+		return nil
+	case *bundlechanges.AddMachineChange:
+		return a.addMachine(change)
+	case *bundlechanges.AddApplicationChange:
+		return a.deploy(change)
+	case *bundlechanges.AddUnitChange:
+		return a.addUnit(change)
+	case *bundlechanges.AddRelationChange:
+		return a.addRelation(change)
+	case *bundlechanges.ExposeChange:
+		return a.expose(change)
+	case *bundlechanges.ScaleChange:
+		return a.scale(change)
+	case *bundlechanges.SetAnnotationsChange:
+		return a.setAnnotations(change)
+	case *bundlechanges.SetOptionsChange:
+		return a.setOptions(change)
+	case *bundlechanges.SetConstraintsChange:
+		return a.setConstraints(change)
+	case *bundlechanges.UpgradeCharmChange:
+		return a.upgradeCharm(change)
+	case *bundlechanges.CreateOfferChange:
+		return a.createOffer(change)
+	case *bundlechanges.GrantOfferAccessChange:
+		return a.grantOfferAccess(change)
+	default:
+		return jujuerrors.NotSupportedf("bundle change %q", change.Method())
+	}
+}
+
+// bundleChangeSupported returns true if the change can be applied by
+// the bundleApplier.
+func bundleChangeSupported(change bundlechanges.Change) bool {
+	switch change.(type) {
+	case *bundlechanges.AddCharmChange,
+		*bundlechanges.AddMachineChange,
+		*bundlechanges.AddApplicationChange,
+		*bundlechanges.AddUnitChange,
+		*bundlechanges.AddRelationChange,
+		*bundlechanges.ExposeChange,
+		*bundlechanges.ScaleChange,
+		*bundlechanges.SetAnnotationsChange,
+		*bundlechanges.SetOptionsChange,
+		*bundlechanges.SetConstraintsChange,
+		*bundlechanges.UpgradeCharmChange,
+		*bundlechanges.CreateOfferChange,
+		*bundlechanges.GrantOfferAccessChange:
+		return true
+	}
+	return false
+}
+
+func (a *bundleApplier) deploy(change *bundlechanges.AddApplicationChange) error {
+	p := change.Params
+	charmParams, ok := a.charms[strings.TrimPrefix(p.Charm, "$")]
+	if !ok {
+		return jujuerrors.Errorf("attempting to deploy %q without its charm", p.Application)
+	}
+	if len(p.LocalResources) > 0 {
+		return jujuerrors.NotSupportedf("local resources for application %q", p.Application)
+	}
+	curl, err := charm.ParseURL(charmParams.Charm)
+	if err != nil {
+		return jujuerrors.Annotatef(err, "charm for application %q", p.Application)
+	}
+	if charm.Local.Matches(curl.Schema) {
+		return jujuerrors.NotSupportedf("local charm %q for application %q", charmParams.Charm, p.Application)
+	}
+
+	args := apiapplication.DeployFromRepositoryArg{
+		CharmName:        curl.Name,
+		ApplicationName:  p.Application,
+		EndpointBindings: p.EndpointBindings,
+		NumUnits:         &p.NumUnits,
+		Revision:         charmParams.Revision,
+	}
+	if channel := firstNonEmpty(p.Channel, charmParams.Channel); channel != "" {
+		args.Channel = &channel
+	}
+	if baseString := firstNonEmpty(p.Base, charmParams.Base); baseString != "" {
+		base, err := corebase.ParseBaseFromString(baseString)
+		if err != nil {
+			return jujuerrors.Trace(err)
+		}
+		args.Base = &base
+	}
+	if args.Cons, err = constraints.Parse(p.Constraints); err != nil {
+		return jujuerrors.Trace(err)
+	}
+	if len(p.Options) > 0 {
+		configYAML, err := goyaml.Marshal(map[string]interface{}{p.Application: p.Options})
+		if err != nil {
+			return jujuerrors.Trace(err)
+		}
+		args.ConfigYAML = string(configYAML)
+	}
+	if len(p.Resources) > 0 {
+		args.Resources = make(map[string]string, len(p.Resources))
+		for name, revision := range p.Resources {
+			args.Resources[name] = strconv.Itoa(revision)
+		}
+	}
+	if len(p.Storage) > 0 {
+		args.Storage = make(map[string]jujustorage.Constraints, len(p.Storage))
+		for name, directive := range p.Storage {
+			if args.Storage[name], err = jujustorage.ParseConstraints(directive); err != nil {
+				return jujuerrors.Annotatef(err, "storage %q of application %q", name, p.Application)
+			}
+		}
+	}
+	if len(p.Devices) > 0 {
+		args.Devices = make(map[string]devices.Constraints, len(p.Devices))
+		for name, directive := range p.Devices {
+			if args.Devices[name], err = devices.ParseConstraints(directive); err != nil {
+				return jujuerrors.Annotatef(err, "device %q of application %q", name, p.Application)
+			}
+		}
+	}
+	if spec := a.data.Applications[p.Application]; spec != nil {
+		args.Trust = spec.RequiresTrust || spec.Options["trust"] == true
+	}
+
+	a.Tracef("Calling DeployFromRepository for bundle application", map[string]interface{}{"application": p.Application})
+	if _, _, errs := a.application.DeployFromRepository(args); len(errs) != 0 {
+		return stderrors.Join(errs...)
+	}
+	a.results[change.Id()] = p.Application
+	return nil
+}
+
+func (a *bundleApplier) addMachine(change *bundlechanges.AddMachineChange) error {
+	p := change.Params
+	cons, err := constraints.Parse(p.Constraints)
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
+	machineParams := params.AddMachineParams{
+		Constraints: cons,
+		Jobs:        []model.MachineJob{model.JobHostUnits},
+	}
+	if p.Base != "" {
+		base, err := corebase.ParseBaseFromString(p.Base)
+		if err != nil {
+			return jujuerrors.Trace(err)
+		}
+		machineParams.Base = &params.Base{Name: base.OS, Channel: base.Channel.String()}
+	}
+	if p.ContainerType != "" {
+		containerType, err := instance.ParseContainerType(p.ContainerType)
+		if err != nil {
+			return jujuerrors.Trace(err)
+		}
+		machineParams.ContainerType = containerType
+		if p.ParentId != "" {
+			parentID, err := a.resolveMachine(p.ParentId)
+			if err != nil {
+				return err
+			}
+			// Never create nested containers.
+			if names.IsContainerMachine(parentID) {
+				parentID = names.NewMachineTag(parentID).Parent().Id()
+			}
+			machineParams.ParentId = parentID
+		}
+	}
+	results, err := a.machines.AddMachines([]params.AddMachineParams{machineParams})
+	if err != nil {
+		return err
+	}
+	if results[0].Error != nil {
+		return results[0].Error
+	}
+	a.results[change.Id()] = results[0].Machine
+	a.createdMachines = append(a.createdMachines, results[0].Machine)
+	return nil
+}
+
+func (a *bundleApplier) addUnit(change *bundlechanges.AddUnitChange) error {
+	p := change.Params
+	applicationName, err := a.resolve(p.Application)
+	if err != nil {
+		return err
+	}
+	var placement []*instance.Placement
+	targetMachine := p.To
+	if targetMachine != "" {
+		// The placement may be "container:machine".
+		container := ""
+		if parts := strings.Split(targetMachine, ":"); len(parts) > 1 {
+			container, targetMachine = parts[0], parts[1]
+		}
+		if targetMachine, err = a.resolveMachine(targetMachine); err != nil {
+			return err
+		}
+		directive := targetMachine
+		if container != "" {
+			directive = container + ":" + directive
+		}
+		unitPlacement, err := utils.ParsePlacement(directive)
+		if err != nil {
+			return jujuerrors.Annotatef(err, "invalid placement %q", directive)
+		}
+		placement = append(placement, unitPlacement)
+	}
+	units, err := a.application.AddUnits(apiapplication.AddUnitsParams{
+		ApplicationName: applicationName,
+		NumUnits:        1,
+		Placement:       placement,
+	})
+	if err != nil {
+		return err
+	}
+	// Record the unit name, its machine is looked up only if another
+	// change is placed relative to it.
+	a.results[change.Id()] = units[0]
+	return nil
+}
+
+func (a *bundleApplier) addRelation(change *bundlechanges.AddRelationChange) error {
+	endpoints := make([]string, 0, 2)
+	for _, endpoint := range []string{change.Params.Endpoint1, change.Params.Endpoint2} {
+		parts := strings.SplitN(endpoint, ":", 2)
+		application, err := a.resolve(parts[0])
+		if err != nil {
+			return err
+		}
+		if len(parts) == 2 {
+			application += ":" + parts[1]
+		}
+		endpoints = append(endpoints, application)
+	}
+	_, err := a.application.AddRelation(endpoints, nil)
+	if err != nil && !params.IsCodeAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (a *bundleApplier) expose(change *bundlechanges.ExposeChange) error {
+	applicationName, err := a.resolve(change.Params.Application)
+	if err != nil {
+		return err
+	}
+	var exposedEndpoints map[string]params.ExposedEndpoint
+	if len(change.Params.ExposedEndpoints) > 0 {
+		exposedEndpoints = make(map[string]params.ExposedEndpoint, len(change.Params.ExposedEndpoints))
+		for endpoint, exposeParams := range change.Params.ExposedEndpoints {
+			exposedEndpoints[endpoint] = params.ExposedEndpoint{
+				ExposeToSpaces: exposeParams.ExposeToSpaces,
+				ExposeToCIDRs:  exposeParams.ExposeToCIDRs,
+			}
+		}
+	}
+	return a.application.Expose(applicationName, exposedEndpoints)
+}
+
+func (a *bundleApplier) scale(change *bundlechanges.ScaleChange) error {
+	applicationName, err := a.resolve(change.Params.Application)
+	if err != nil {
+		return err
+	}
+	result, err := a.application.ScaleApplication(apiapplication.ScaleApplicationParams{
+		ApplicationName: applicationName,
+		Scale:           change.Params.Scale,
+	})
+	if err != nil {
+		return err
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+func (a *bundleApplier) setAnnotations(change *bundlechanges.SetAnnotationsChange) error {
+	id, err := a.resolve(change.Params.Id)
+	if err != nil {
+		return err
+	}
+	var tag names.Tag
+	switch change.Params.EntityType {
+	case bundlechanges.ApplicationType:
+		tag = names.NewApplicationTag(id)
+	case bundlechanges.MachineType:
+		tag = names.NewMachineTag(id)
+	default:
+		return jujuerrors.NotSupportedf("annotations on %q entities", change.Params.EntityType)
+	}
+	results, err := a.annotations.Set(map[string]map[string]string{tag.String(): change.Params.Annotations})
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+func (a *bundleApplier) setOptions(change *bundlechanges.SetOptionsChange) error {
+	configYAML, err := goyaml.Marshal(map[string]interface{}{change.Params.Application: change.Params.Options})
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
+	return a.application.SetConfig(model.GenerationMaster, change.Params.Application, string(configYAML), nil)
+}
+
+func (a *bundleApplier) setConstraints(change *bundlechanges.SetConstraintsChange) error {
+	cons, err := constraints.Parse(change.Params.Constraints)
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
+	return a.application.SetConstraints(change.Params.Application, cons)
+}
+
+func (a *bundleApplier) upgradeCharm(change *bundlechanges.UpgradeCharmChange) error {
+	p := change.Params
+	input := &UpdateApplicationInput{
+		ModelUUID: a.modelUUID,
+		AppName:   p.Application,
+		Channel:   p.Channel,
+		Base:      p.Base,
+	}
+	if charmParams, ok := a.charms[strings.TrimPrefix(p.Charm, "$")]; ok {
+		input.Channel = firstNonEmpty(p.Channel, charmParams.Channel)
+		input.Revision = charmParams.Revision
+	}
+	if len(p.Resources) > 0 {
+		input.Resources = make(map[string]CharmResource, len(p.Resources))
+		for name, revision := range p.Resources {
+			input.Resources[name] = CharmResource{RevisionNumber: strconv.Itoa(revision)}
+		}
+	}
+	return a.updateApplication(input)
+}
+
+func (a *bundleApplier) createOffer(change *bundlechanges.CreateOfferChange) error {
+	p := change.Params
+	results, err := a.offers.Offer(a.modelUUID, p.Application, p.Endpoints, a.offerOwner, p.OfferName, "")
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+func (a *bundleApplier) grantOfferAccess(change *bundlechanges.GrantOfferAccessChange) error {
+	modelOwner, modelName, err := a.ModelOwnerAndName(a.modelUUID)
+	if err != nil {
+		return err
+	}
+	p := change.Params
+	url := offerURL(modelOwner, modelName, p.Offer)
+	// Juju refuses to grant an access the user already has, or a lower
+	// one, without a typed error, so check the access of the user first.
+	offer, err := a.offers.ApplicationOffer(url)
+	if err != nil {
+		return err
+	}
+	for _, user := range offer.Users {
+		if user.UserName == p.User && user.Access.EqualOrGreaterOfferAccessThan(permission.Access(p.Access)) {
+			return nil
+		}
+	}
+	err = a.offers.GrantOffer(p.User, p.Access, url)
+	if err != nil && !params.IsCodeAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// resolve returns the name of the entity created by the change referred
+// to by placeholder, e.g. "$deploy-3". Values which are not placeholders
+// are returned unchanged.
+func (a *bundleApplier) resolve(placeholder string) (string, error) {
+	if !strings.HasPrefix(placeholder, "$") {
+		return placeholder, nil
+	}
+	result, ok := a.results[placeholder[1:]]
+	if !ok {
+		return "", jujuerrors.NotFoundf("result of bundle change %q", placeholder[1:])
+	}
+	return result, nil
+}
+
+// resolveMachine returns the machine ID referred to by placeholder. When
+// the placeholder refers to a unit, the machine the unit is assigned to
+// is returned once known.
+func (a *bundleApplier) resolveMachine(placeholder string) (string, error) {
+	machineOrUnit, err := a.resolve(placeholder)
+	if err != nil {
+		return "", err
+	}
+	if !names.IsValidUnit(machineOrUnit) {
+		return machineOrUnit, nil
+	}
+
+	ctx, cancel := context.WithTimeout(a.ctx, BundleUnitMachineTimeout)
+	defer cancel()
+	tick := time.NewTicker(BundleApiTickWait)
+	defer tick.Stop()
+	applicationName, _ := names.UnitApplication(machineOrUnit)
+	for {
+		status, err := a.client.Status(nil)
+		if err != nil {
+			return "", err
+		}
+		if unit, ok := status.Applications[applicationName].Units[machineOrUnit]; ok && unit.Machine != "" {
+			return unit.Machine, nil
+		}
+		select {
+		case <-tick.C:
+		case <-ctx.Done():
+			return "", jujuerrors.Annotatef(ctx.Err(), "waiting for unit %q to be assigned to a machine", machineOrUnit)
+		}
+	}
+}
+
+// bundleModelExtractor provides what is needed to build the
+// representation of a model used to compute bundle changes.
+type bundleModelExtractor struct {
+	ApplicationAPIClient
+	annotations AnnotationsAPIClient
+	modelConfig ModelConfigAPIClient
+}
+
+// GetAnnotations is part of the bundle.ModelExtractor interface. The
+// extractor methods skip the API call when there is nothing to get, which
+// is the case when deploying to an empty model.
+func (e bundleModelExtractor) GetAnnotations(tags []string) ([]params.AnnotationsGetResult, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	return e.annotations.Get(tags)
+}
+
+// GetConfig is part of the bundle.ModelExtractor interface.
+func (e bundleModelExtractor) GetConfig(branchName string, appNames ...string) ([]map[string]interface{}, error) {
+	if len(appNames) == 0 {
+		return nil, nil
+	}
+	return e.ApplicationAPIClient.GetConfig(branchName, appNames...)
+}
+
+// GetConstraints is part of the bundle.ModelExtractor interface.
+func (e bundleModelExtractor) GetConstraints(applications ...string) ([]constraints.Value, error) {
+	if len(applications) == 0 {
+		return nil, nil
+	}
+	return e.ApplicationAPIClient.GetConstraints(applications...)
+}
+
+// Sequences is part of the bundle.ModelExtractor interface.
+func (e bundleModelExtractor) Sequences() (map[string]int, error) {
+	return e.modelConfig.Sequences()
+}
+
+// bundleChangesLogger sends the bundle changes logs to the shared
+// client logger.
+type bundleChangesLogger struct {
+	SharedClient
+}
+
+// Tracef is part of the bundlechanges.Logger interface.
+func (l bundleChangesLogger) Tracef(msg string, args ...interface{}) {
+	l.SharedClient.Tracef(fmt.Sprintf(msg, args...))
+}
+
+// bundleArchConstraint computes the architecture of a bundle
+// application, falling back to the model constraints.
+type bundleArchConstraint struct {
+	constraints      string
+	modelConstraints constraints.Value
+}
+
+// Arch is part of the bundlechanges.ArchConstraint interface.
+func (b bundleArchConstraint) Arch() (string, error) {
+	cons, err := constraints.Parse(b.constraints)
+	if err != nil {
+		return "", jujuerrors.Trace(err)
+	}
+	return constraints.ArchOrDefault(cons, &b.modelConstraints), nil
+}
+
+func bundleConstraintsParser(modelConstraints constraints.Value) bundlechanges.ConstraintGetter {
+	return func(s string) bundlechanges.ArchConstraint {
+		return bundleArchConstraint{constraints: s, modelConstraints: modelConstraints}
+	}
+}
+
+// bundleIntegrations returns the keys of the integrations between the
+// given applications, e.g. "wordpress:db mysql:server", sorted.
+func bundleIntegrations(status *params.FullStatus, applications []string) []string {
+	var integrations []string
+	for _, relation := range status.Relations {
+		// Peer relations are not created by bundles.
+		if len(relation.Endpoints) != 2 {
+			continue
+		}
+		if slices.Contains(applications, relation.Endpoints[0].ApplicationName) &&
+			slices.Contains(applications, relation.Endpoints[1].ApplicationName) {
+			integrations = append(integrations, relation.Key)
+		}
+	}
+	sort.Strings(integrations)
+	return integrations
+}
+
+func bundleHasOffers(data *charm.BundleData) bool {
+	for _, app := range data.Applications {
+		if len(app.Offers) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func offerURL(modelOwner, modelName, offerName string) string {
+	return fmt.Sprintf("%s/%s.%s", modelOwner, modelName, offerName)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
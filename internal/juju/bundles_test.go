@@ -0,0 +1,363 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/juju/juju/api"
+	apiapplication "github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/charmhub/transport"
+	bundlechanges "github.com/juju/juju/core/bundle/changes"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+const testBundle = `
+applications:
+  mysql:
+    charm: mysql
+    channel: 8.0/stable
+    num_units: 1
+    to: ["0"]
+    options:
+      profile: testing
+  wordpress:
+    charm: wordpress
+    num_units: 1
+    expose: true
+machines:
+  "0":
+    constraints: mem=4G
+relations:
+  - [wordpress:db, mysql:database]
+---
+applications:
+  mysql:
+    offers:
+      db:
+        endpoints: [database]
+`
+
+type BundleSuite struct {
+	suite.Suite
+	JujuSuite
+
+	testModelUUID string
+
+	mockAnnotationsClient       *MockAnnotationsAPIClient
+	mockApplicationClient       *MockApplicationAPIClient
+	mockApplicationOffersClient *MockApplicationOffersAPIClient
+	mockClient                  *MockClientAPIClient
+	mockMachineManagerClient    *MockMachineManagerAPIClient
+	mockModelConfigClient       *MockModelConfigAPIClient
+	mockCharmhubClient          *MockCharmhubClient
+
+	updatedApplications []*UpdateApplicationInput
+}
+
+func (s *BundleSuite) setupMocks(t *testing.T) *gomock.Controller {
+	s.testModelUUID = "test-uuid"
+	s.updatedApplications = nil
+
+	ctlr := gomock.NewController(t)
+	s.mockAnnotationsClient = NewMockAnnotationsAPIClient(ctlr)
+	s.mockApplicationClient = NewMockApplicationAPIClient(ctlr)
+	s.mockApplicationOffersClient = NewMockApplicationOffersAPIClient(ctlr)
+	s.mockClient = NewMockClientAPIClient(ctlr)
+	s.mockMachineManagerClient = NewMockMachineManagerAPIClient(ctlr)
+	s.mockModelConfigClient = NewMockModelConfigAPIClient(ctlr)
+	s.mockCharmhubClient = NewMockCharmhubClient(ctlr)
+
+	s.mockConnection = NewMockConnection(ctlr)
+	s.mockConnection.EXPECT().Close().Return(nil).AnyTimes()
+
+	log := func(msg string, additionalFields ...map[string]interface{}) {
+		s.T().Logf("logging from shared client %q, %+v", msg, additionalFields)
+	}
+	s.mockSharedClient = NewMockSharedClient(ctlr)
+	s.mockSharedClient.EXPECT().Debugf(gomock.Any(), gomock.Any()).Do(log).AnyTimes()
+	s.mockSharedClient.EXPECT().Tracef(gomock.Any(), gomock.Any()).Do(log).AnyTimes()
+	s.mockSharedClient.EXPECT().GetConnection(gomock.Any()).Return(s.mockConnection, nil).AnyTimes()
+	s.mockSharedClient.EXPECT().ModelOwnerAndName(s.testModelUUID).Return("admin", "test-model", nil).AnyTimes()
+
+	return ctlr
+}
+
+func (s *BundleSuite) getBundlesClient() bundlesClient {
+	return bundlesClient{
+		SharedClient: s.mockSharedClient,
+		getAnnotationsAPIClient: func(_ api.Connection) AnnotationsAPIClient {
+			return s.mockAnnotationsClient
+		},
+		getApplicationAPIClient: func(_ api.Connection) ApplicationAPIClient {
+			return s.mockApplicationClient
+		},
+		getApplicationOffersAPIClient: func(_ api.Connection) ApplicationOffersAPIClient {
+			return s.mockApplicationOffersClient
+		},
+		getClientAPIClient: func(_ api.Connection) ClientAPIClient {
+			return s.mockClient
+		},
+		getMachineManagerAPIClient: func(_ api.Connection) MachineManagerAPIClient {
+			return s.mockMachineManagerClient
+		},
+		getModelConfigAPIClient: func(_ api.Connection) ModelConfigAPIClient {
+			return s.mockModelConfigClient
+		},
+		updateApplication: func(input *UpdateApplicationInput) error {
+			s.updatedApplications = append(s.updatedApplications, input)
+			return nil
+		},
+	}
+}
+
+func (s *BundleSuite) TestMergeBundleDataWithOverlays() {
+	overlay := `
+applications:
+  mysql:
+    options:
+      profile: production
+  wordpress:
+`
+	data, err := mergeBundleData(testBundle, []string{overlay})
+	s.Require().NoError(err)
+	s.Assert().Len(data.Applications, 1)
+	s.Require().Contains(data.Applications, "mysql")
+	s.Assert().Equal("production", data.Applications["mysql"].Options["profile"])
+	// The relation to the removed application is dropped too.
+	s.Assert().Empty(data.Relations)
+}
+
+func (s *BundleSuite) TestMergeBundleDataInvalid() {
+	_, err := mergeBundleData(`
+applications:
+  mysql:
+    charm: mysql
+    constraints: not-a-constraint
+`, nil)
+	s.Assert().ErrorContains(err, "verifying bundle")
+}
+
+func (s *BundleSuite) TestCharmhubBundleYAML() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockCharmhubClient.EXPECT().Info(gomock.Any(), "wordpress-bundle", gomock.Any()).Return(transport.InfoResponse{
+		Type: transport.BundleType,
+		DefaultRelease: transport.InfoChannelMap{
+			Revision: transport.InfoRevision{BundleYAML: testBundle},
+		},
+	}, nil)
+	bundleYAML, err := charmhubBundleYAML(context.Background(), s.mockCharmhubClient, "wordpress-bundle", "latest/stable")
+	s.Require().NoError(err)
+	s.Assert().Equal(testBundle, bundleYAML)
+
+	s.mockCharmhubClient.EXPECT().Info(gomock.Any(), "mysql", gomock.Any()).Return(transport.InfoResponse{
+		Type: transport.CharmType,
+	}, nil)
+	_, err = charmhubBundleYAML(context.Background(), s.mockCharmhubClient, "mysql", "")
+	s.Assert().ErrorContains(err, `"mysql" is a charm, not a bundle`)
+}
+
+func (s *BundleSuite) TestApplyBundle() {
+	defer s.setupMocks(s.T()).Finish()
+	client := s.getBundlesClient()
+
+	s.mockSharedClient.EXPECT().ModelStatus(s.testModelUUID, gomock.Any()).Return(&params.FullStatus{
+		Model: params.ModelStatusInfo{Type: "iaas"},
+	}, nil)
+	s.mockModelConfigClient.EXPECT().Sequences().Return(map[string]int{}, nil)
+	s.mockModelConfigClient.EXPECT().GetModelConstraints().Return(constraints.Value{}, nil)
+
+	deployMySQL := s.mockApplicationClient.EXPECT().DeployFromRepository(gomock.Any()).DoAndReturn(
+		func(arg apiapplication.DeployFromRepositoryArg) (apiapplication.DeployInfo, []apiapplication.PendingResourceUpload, []error) {
+			s.Assert().Equal("mysql", arg.CharmName)
+			s.Assert().Equal("mysql", arg.ApplicationName)
+			s.Require().NotNil(arg.Channel)
+			s.Assert().Equal("8.0/stable", *arg.Channel)
+			s.Require().NotNil(arg.NumUnits)
+			s.Assert().Equal(0, *arg.NumUnits, "units are added by separate changes on IAAS models")
+			s.Assert().Contains(arg.ConfigYAML, "profile: testing")
+			return apiapplication.DeployInfo{Name: "mysql"}, nil, nil
+		})
+	deployWordpress := s.mockApplicationClient.EXPECT().DeployFromRepository(gomock.Any()).DoAndReturn(
+		func(arg apiapplication.DeployFromRepositoryArg) (apiapplication.DeployInfo, []apiapplication.PendingResourceUpload, []error) {
+			s.Assert().Equal("wordpress", arg.ApplicationName)
+			return apiapplication.DeployInfo{Name: "wordpress"}, nil, nil
+		})
+	addMachine := s.mockMachineManagerClient.EXPECT().AddMachines(gomock.Any()).DoAndReturn(
+		func(machineParams []params.AddMachineParams) ([]params.AddMachinesResult, error) {
+			s.Require().Len(machineParams, 1)
+			s.Assert().Equal("mem=4096M", machineParams[0].Constraints.String())
+			return []params.AddMachinesResult{{Machine: "3"}}, nil
+		})
+	addUnits := s.mockApplicationClient.EXPECT().AddUnits(gomock.Any()).DoAndReturn(
+		func(args apiapplication.AddUnitsParams) ([]string, error) {
+			if args.ApplicationName == "mysql" {
+				s.Require().Len(args.Placement, 1)
+				s.Assert().Equal("3", args.Placement[0].Directive, "the bundle machine 0 maps to the new machine 3")
+				return []string{"mysql/0"}, nil
+			}
+			s.Assert().Empty(args.Placement)
+			return []string{"wordpress/0"}, nil
+		}).Times(2)
+	addRelation := s.mockApplicationClient.EXPECT().AddRelation([]string{"wordpress:db", "mysql:database"}, nil).Return(&params.AddRelationResults{}, nil)
+	s.mockApplicationClient.EXPECT().Expose("wordpress", nil).Return(nil)
+	createOffer := s.mockApplicationOffersClient.EXPECT().Offer(s.testModelUUID, "mysql", []string{"database"}, "admin", "db", "").Return(nil, nil)
+
+	gomock.InOrder(deployMySQL, addMachine, addUnits)
+	gomock.InOrder(deployWordpress, addRelation)
+	gomock.InOrder(deployMySQL, createOffer)
+
+	s.mockClient.EXPECT().Status(nil).Return(&params.FullStatus{
+		Relations: []params.RelationStatus{
+			{
+				Key: "wordpress:db mysql:database",
+				Endpoints: []params.EndpointStatus{
+					{ApplicationName: "wordpress", Name: "db"},
+					{ApplicationName: "mysql", Name: "database"},
+				},
+			},
+			{
+				Key:       "mysql:cluster",
+				Endpoints: []params.EndpointStatus{{ApplicationName: "mysql", Name: "cluster"}},
+			},
+		},
+	}, nil)
+
+	resp, err := client.ApplyBundle(context.Background(), &ApplyBundleInput{
+		ModelUUID:  s.testModelUUID,
+		Bundle:     testBundle,
+		OfferOwner: "admin",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"mysql", "wordpress"}, resp.Applications)
+	s.Assert().Equal([]string{"3"}, resp.Machines)
+	s.Assert().Equal([]string{"wordpress:db mysql:database"}, resp.Integrations)
+	s.Assert().Equal([]string{"admin/test-model.db"}, resp.Offers)
+}
+
+func (s *BundleSuite) TestApplyBundleUnsupportedChange() {
+	defer s.setupMocks(s.T()).Finish()
+	client := s.getBundlesClient()
+
+	s.mockSharedClient.EXPECT().ModelStatus(s.testModelUUID, gomock.Any()).Return(&params.FullStatus{
+		Model: params.ModelStatusInfo{Type: "iaas"},
+	}, nil)
+	s.mockModelConfigClient.EXPECT().Sequences().Return(map[string]int{}, nil)
+	s.mockModelConfigClient.EXPECT().GetModelConstraints().Return(constraints.Value{}, nil)
+
+	_, err := client.ApplyBundle(context.Background(), &ApplyBundleInput{
+		ModelUUID: s.testModelUUID,
+		Bundle: `
+saas:
+  postgresql:
+    url: admin/other.postgresql
+applications:
+  wordpress:
+    charm: wordpress
+relations:
+  - [wordpress:db, postgresql:db]
+`,
+	})
+	s.Assert().ErrorContains(err, `bundle change "consumeOffer" not supported`)
+}
+
+func (s *BundleSuite) TestGrantOfferAccess() {
+	defer s.setupMocks(s.T()).Finish()
+	applier := &bundleApplier{
+		bundlesClient: s.getBundlesClient(),
+		modelUUID:     s.testModelUUID,
+		offers:        s.mockApplicationOffersClient,
+	}
+	grant := func(user, access string) error {
+		return applier.grantOfferAccess(&bundlechanges.GrantOfferAccessChange{
+			Params: bundlechanges.GrantOfferAccessParams{User: user, Access: access, Offer: "db"},
+		})
+	}
+
+	s.mockApplicationOffersClient.EXPECT().ApplicationOffer("admin/test-model.db").Return(&crossmodel.ApplicationOfferDetails{
+		Users: []crossmodel.OfferUserDetails{
+			{UserName: "alice", Access: permission.ConsumeAccess},
+			{UserName: "bob", Access: permission.ReadAccess},
+		},
+	}, nil).Times(3)
+	s.mockApplicationOffersClient.EXPECT().GrantOffer("bob", "consume", "admin/test-model.db").Return(nil)
+	s.mockApplicationOffersClient.EXPECT().GrantOffer("carol", "read", "admin/test-model.db").Return(errors.New("permission denied"))
+
+	s.Assert().NoError(grant("alice", "read"), "alice already has a greater access")
+	s.Assert().NoError(grant("bob", "consume"))
+	s.Assert().ErrorContains(grant("carol", "read"), "permission denied")
+}
+
+func (s *BundleSuite) TestReadBundle() {
+	defer s.setupMocks(s.T()).Finish()
+	client := s.getBundlesClient()
+
+	s.mockSharedClient.EXPECT().ModelStatus(s.testModelUUID, gomock.Any()).Return(&params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{
+			"mysql": {},
+		},
+		Machines: map[string]params.MachineStatus{
+			"3": {},
+		},
+		Offers: map[string]params.ApplicationOfferStatus{
+			"db": {ApplicationName: "mysql"},
+		},
+		Relations: []params.RelationStatus{
+			{
+				Key: "mysql:database other:db",
+				Endpoints: []params.EndpointStatus{
+					{ApplicationName: "mysql", Name: "database"},
+					{ApplicationName: "other", Name: "db"},
+				},
+			},
+		},
+	}, nil)
+
+	resp, err := client.ReadBundle(&ReadBundleInput{
+		ModelUUID:    s.testModelUUID,
+		Applications: []string{"mysql", "wordpress"},
+		Machines:     []string{"3", "4"},
+		Offers:       []string{"admin/test-model.db", "admin/test-model.web"},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"mysql"}, resp.Applications)
+	s.Assert().Equal([]string{"3"}, resp.Machines)
+	s.Assert().Equal([]string{"admin/test-model.db"}, resp.Offers)
+	s.Assert().Empty(resp.Integrations, "integrations with applications outside the bundle are not reported")
+}
+
+func (s *BundleSuite) TestDestroyBundle() {
+	defer s.setupMocks(s.T()).Finish()
+	client := s.getBundlesClient()
+
+	destroyOffers := s.mockApplicationOffersClient.EXPECT().DestroyOffers(false, "admin/test-model.db").Return(nil)
+	destroyApplications := s.mockApplicationClient.EXPECT().DestroyApplications(apiapplication.DestroyApplicationsParams{
+		Applications: []string{"mysql", "wordpress"},
+	}).Return([]params.DestroyApplicationResult{
+		{},
+		{Error: &params.Error{Code: params.CodeNotFound, Message: "application wordpress not found"}},
+	}, nil)
+	gomock.InOrder(destroyOffers, destroyApplications)
+
+	err := client.DestroyBundle(&DestroyBundleInput{
+		ModelUUID:    s.testModelUUID,
+		Applications: []string{"mysql", "wordpress"},
+		Offers:       []string{"admin/test-model.db"},
+	})
+	s.Assert().NoError(err)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestBundleSuite(t *testing.T) {
+	suite.Run(t, new(BundleSuite))
+}
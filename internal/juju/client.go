@@ -50,26 +50,41 @@ type ControllerConfiguration struct {
 	CACert              string
 	ClientID            string
 	ClientSecret        string
+	// ControllerName is the name of the controller in the local Juju
+	// client store. It is only used to log in with the account of the
+	// store when no password or client credentials are given.
+	ControllerName string
+}
+
+// loginViaClientStore returns whether to log in with the account and
+// cookies stored by the Juju client rather than with credentials.
+func (c ControllerConfiguration) loginViaClientStore() bool {
+	return c.ControllerName != "" && c.Password == "" && c.ClientID == ""
 }
 
 // Client holds the various juju api clients used to interact with the juju controller.
 type Client struct {
-	Applications applicationsClient
-	Machines     *machinesClient
-	Clouds       kubernetesCloudsClient
-	Credentials  credentialsClient
-	Integrations integrationsClient
-	Models       modelsClient
-	Offers       offersClient
-	SSHKeys      sshKeysClient
-	Users        usersClient
-	Secrets      secretsClient
-	Jaas         jaasClient
-	Annotations  annotationsClient
-	Storage      storageClient
-
-	isJAAS   func() bool
-	username string
+	Actions        actionsClient
+	Applications   applicationsClient
+	Bundles        bundlesClient
+	Machines       *machinesClient
+	Clouds         kubernetesCloudsClient
+	Credentials    credentialsClient
+	Integrations   integrationsClient
+	Models         modelsClient
+	Offers         offersClient
+	SSHKeys        sshKeysClient
+	Users          usersClient
+	Secrets        secretsClient
+	SecretBackends secretBackendsClient
+	Spaces         spacesClient
+	Jaas           jaasClient
+	Annotations    annotationsClient
+	Storage        storageClient
+
+	isJAAS       func() bool
+	modelChanged func(modelUUID string) <-chan struct{}
+	username     string
 }
 
 // Config holds configuration options for the Juju provider.
@@ -103,6 +118,13 @@ func (c Client) IsJAAS() bool {
 	return c.isJAAS()
 }
 
+// ModelChanged returns a channel which is closed the next time the model
+// with the given UUID changes. The channel is nil if the model cannot be
+// watched, so that waiting on it falls back to polling.
+func (c Client) ModelChanged(modelUUID string) <-chan struct{} {
+	return c.modelChanged(modelUUID)
+}
+
 // Username returns the username specified in the Juju provider or, if specified, the
 // service account username.
 func (c Client) Username() string {
@@ -129,6 +151,15 @@ type sharedClient struct {
 
 	modelStatusCache *cache.Cache
 
+	// connections holds the authenticated connections to the controller
+	// and its models, so that they are reused for the provider lifetime.
+	connections *connectionPool
+
+	// watchers keep the models being waited on up to date from the
+	// AllWatcher, keyed by model UUID.
+	watchers   map[string]*modelWatcher
+	watchersMu sync.Mutex
+
 	// subCtx is the context created with the new tflog subsystem for applications.
 	subCtx context.Context
 
@@ -148,8 +179,10 @@ func NewClient(ctx context.Context, config ControllerConfiguration, waitForResou
 		waitForResources: waitForResources,
 		modelUUIDcache:   make(map[string]jujuModel),
 		modelStatusCache: cache.New(defaultModelStatusCacheInterval),
+		watchers:         make(map[string]*modelWatcher),
 		subCtx:           tflog.NewSubsystem(ctx, LogJujuClient),
 	}
+	sc.connections = newConnectionPool(sc.dial)
 	// Client ID and secret are only set when connecting to JAAS. Use this as a fallback
 	// value if connecting to the controller fails.
 	defaultJAASCheck := false
@@ -162,22 +195,28 @@ func NewClient(ctx context.Context, config ControllerConfiguration, waitForResou
 		user = fmt.Sprintf("%s%s", config.ClientID, serviceAccountSuffix)
 	}
 
+	applications := newApplicationClient(sc)
 	return &Client{
-		Applications: *newApplicationClient(sc),
-		Clouds:       *newKubernetesCloudsClient(sc),
-		Credentials:  *newCredentialsClient(sc),
-		Integrations: *newIntegrationsClient(sc),
-		Machines:     newMachinesClient(sc),
-		Models:       *newModelsClient(sc),
-		Offers:       *newOffersClient(sc),
-		SSHKeys:      *newSSHKeysClient(sc),
-		Users:        *newUsersClient(sc),
-		Secrets:      *newSecretsClient(sc),
-		Jaas:         *newJaasClient(sc),
-		Annotations:  *newAnnotationsClient(sc),
-		Storage:      *newStorageClient(sc),
-		isJAAS:       func() bool { return sc.IsJAAS(defaultJAASCheck) },
-		username:     user,
+		Actions:        *newActionsClient(sc),
+		Applications:   *applications,
+		Bundles:        *newBundlesClient(sc, applications),
+		Clouds:         *newKubernetesCloudsClient(sc),
+		Credentials:    *newCredentialsClient(sc),
+		Integrations:   *newIntegrationsClient(sc),
+		Machines:       newMachinesClient(sc),
+		Models:         *newModelsClient(sc),
+		Offers:         *newOffersClient(sc),
+		SSHKeys:        *newSSHKeysClient(sc),
+		Users:          *newUsersClient(sc),
+		Secrets:        *newSecretsClient(sc),
+		SecretBackends: *newSecretBackendsClient(sc),
+		Spaces:         *newSpacesClient(sc),
+		Jaas:           *newJaasClient(sc),
+		Annotations:    *newAnnotationsClient(sc),
+		Storage:        *newStorageClient(sc),
+		isJAAS:         func() bool { return sc.IsJAAS(defaultJAASCheck) },
+		modelChanged:   sc.ModelChanged,
+		username:       user,
 	}, nil
 }
 
@@ -228,38 +267,61 @@ func (sc *sharedClient) WaitForResource() bool {
 // GetConnection returns a juju connection for use creating juju
 // api clients. A model UUID can optionally be provided to connect
 // to a specific model.
+//
+// Connections are taken from a pool shared by all resources. Callers
+// must close the connection once done with it, which releases it back
+// to the pool rather than disconnecting.
 func (sc *sharedClient) GetConnection(modelUUID *string) (api.Connection, error) {
-	dialOptions := func(do *api.DialOpts) {
-		//this is set as a const above, in case we need to use it elsewhere to manage connection timings
-		do.Timeout = getConnectionTimeout()
-		//default is 2 seconds, as we are changing the overall timeout it makes sense to reduce this as well
-		do.RetryDelay = 1 * time.Second
-	}
-
 	var modelUUIDStr string
 	if modelUUID != nil {
 		modelUUIDStr = *modelUUID
 	}
+	return sc.connections.Get(modelUUIDStr)
+}
 
-	connr, err := connector.NewSimple(connector.SimpleConfig{
-		ControllerAddresses: sc.controllerConfig.ControllerAddresses,
-		Username:            sc.controllerConfig.Username,
-		Password:            sc.controllerConfig.Password,
-		ClientID:            sc.controllerConfig.ClientID,
-		ClientSecret:        sc.controllerConfig.ClientSecret,
-		CACert:              sc.controllerConfig.CACert,
-		ModelUUID:           modelUUIDStr,
-	}, dialOptions)
+// dial opens and logs in a new connection to the controller, or to the
+// model with the given UUID if it is not empty.
+func (sc *sharedClient) dial(modelUUID string) (api.Connection, error) {
+	conn, err := dialController(sc.controllerConfig, modelUUID)
 	if err != nil {
+		sc.Errorf(err, "connection not established")
 		return nil, err
 	}
+	return conn, nil
+}
 
-	conn, err := connr.Connect()
+// dialController opens and logs in a new connection to the controller
+// described by config, or to one of its models if modelUUID is not empty.
+func dialController(config ControllerConfiguration, modelUUID string) (api.Connection, error) {
+	dialOptions := func(do *api.DialOpts) {
+		//this is set as a const above, in case we need to use it elsewhere to manage connection timings
+		do.Timeout = getConnectionTimeout()
+		//default is 2 seconds, as we are changing the overall timeout it makes sense to reduce this as well
+		do.RetryDelay = 1 * time.Second
+	}
+
+	var connr connector.Connector
+	var err error
+	if config.loginViaClientStore() {
+		connr, err = connector.NewClientStore(connector.ClientStoreConfig{
+			ControllerName: config.ControllerName,
+			ModelUUID:      modelUUID,
+		}, dialOptions)
+	} else {
+		connr, err = connector.NewSimple(connector.SimpleConfig{
+			ControllerAddresses: config.ControllerAddresses,
+			Username:            config.Username,
+			Password:            config.Password,
+			ClientID:            config.ClientID,
+			ClientSecret:        config.ClientSecret,
+			CACert:              config.CACert,
+			ModelUUID:           modelUUID,
+		}, dialOptions)
+	}
 	if err != nil {
-		sc.Errorf(err, "connection not established")
 		return nil, err
 	}
-	return conn, nil
+	return connr.Connect()
 }
 
 // initializeModelCache is a helper function to ensure that the model cache is filled at
@@ -410,6 +472,7 @@ func (sc *sharedClient) getModelStatusFunc(uuid string, conn api.Connection) fun
 			if err != nil {
 				return nil, err
 			}
+			defer func() { _ = conn.Close() }()
 		}
 
 		client := apiclient.NewClient(conn, sc.JujuLogger())
@@ -423,7 +486,19 @@ func (sc *sharedClient) getModelStatusFunc(uuid string, conn api.Connection) fun
 }
 
 // ModelStatus returns the status of the model identified by its UUID.
+// While the model is watched, the status is only fetched again once the
+// model changed. Otherwise, it is cached for a few seconds.
 func (sc *sharedClient) ModelStatus(modelUUID string, conn api.Connection) (*params.FullStatus, error) {
+	if w, ok := sc.runningModelWatcher(modelUUID); ok {
+		return w.modelStatus(func() (*params.FullStatus, error) {
+			status, err := sc.getModelStatusFunc(modelUUID, conn)()
+			if err != nil {
+				return nil, err
+			}
+			return status.(*params.FullStatus), nil
+		})
+	}
+
 	status, err := sc.modelStatusCache.Get(modelUUID, sc.getModelStatusFunc(modelUUID, conn))
 	if err != nil {
 		return nil, err
@@ -0,0 +1,175 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/juju/api"
+)
+
+const (
+	// defaultMaxIdleConnections is the number of idle connections kept
+	// per model. Connections released beyond this limit are closed.
+	defaultMaxIdleConnections = 4
+	// defaultConnectionIdleTimeout is how long a connection may remain
+	// unused in the pool before it is closed.
+	defaultConnectionIdleTimeout = 5 * time.Minute
+)
+
+// idleConnection is a connection waiting in the pool to be reused.
+type idleConnection struct {
+	conn  api.Connection
+	since time.Time
+}
+
+// connectionPool keeps authenticated connections to the controller,
+// keyed by model UUID, so that they can be reused instead of dialing
+// and logging in for every API call. The controller connection uses
+// the empty string as its key.
+//
+// Connections are handed out to a single caller at a time and are
+// returned to the pool when the caller closes them. Broken connections
+// are discarded and connections left idle for longer than the idle
+// timeout are closed.
+type connectionPool struct {
+	dial        func(modelUUID string) (api.Connection, error)
+	maxIdle     int
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	mu   sync.Mutex
+	idle map[string][]idleConnection
+}
+
+// newConnectionPool returns a pool which uses dial to open new
+// connections.
+func newConnectionPool(dial func(modelUUID string) (api.Connection, error)) *connectionPool {
+	return &connectionPool{
+		dial:        dial,
+		maxIdle:     defaultMaxIdleConnections,
+		idleTimeout: defaultConnectionIdleTimeout,
+		now:         time.Now,
+		idle:        make(map[string][]idleConnection),
+	}
+}
+
+// Get returns a healthy connection to the model with the given UUID,
+// reusing an idle one if possible. Closing the returned connection
+// releases it back to the pool.
+func (p *connectionPool) Get(modelUUID string) (api.Connection, error) {
+	for {
+		conn, ok := p.takeIdle(modelUUID)
+		if !ok {
+			break
+		}
+		// IsBroken pings the controller if the connection has not
+		// already been detected as broken.
+		if conn.IsBroken() {
+			_ = conn.Close()
+			continue
+		}
+		return &pooledConnection{Connection: conn, pool: p, modelUUID: modelUUID}, nil
+	}
+
+	conn, err := p.dial(modelUUID)
+	if err != nil {
+		return nil, err
+	}
+	return &pooledConnection{Connection: conn, pool: p, modelUUID: modelUUID}, nil
+}
+
+// takeIdle removes and returns the most recently used idle connection
+// to the model, after evicting the expired connections of all models.
+func (p *connectionPool) takeIdle(modelUUID string) (api.Connection, bool) {
+	p.mu.Lock()
+	expired := p.evictLocked()
+	var (
+		conn api.Connection
+		ok   bool
+	)
+	if conns := p.idle[modelUUID]; len(conns) > 0 {
+		conn, ok = conns[len(conns)-1].conn, true
+		p.idle[modelUUID] = conns[:len(conns)-1]
+	}
+	p.mu.Unlock()
+
+	closeConnections(expired)
+	return conn, ok
+}
+
+// release returns the connection to the pool, or closes it if it is
+// broken or the pool already holds enough idle connections to the model.
+func (p *connectionPool) release(modelUUID string, conn api.Connection) error {
+	select {
+	case <-conn.Broken():
+		return conn.Close()
+	default:
+	}
+
+	p.mu.Lock()
+	expired := p.evictLocked()
+	full := len(p.idle[modelUUID]) >= p.maxIdle
+	if !full {
+		p.idle[modelUUID] = append(p.idle[modelUUID], idleConnection{conn: conn, since: p.now()})
+	}
+	p.mu.Unlock()
+
+	closeConnections(expired)
+	if full {
+		return conn.Close()
+	}
+	return nil
+}
+
+// evictLocked removes the connections which have been idle for longer
+// than the idle timeout and returns them so that they can be closed
+// once the lock is released. The caller must hold p.mu.
+func (p *connectionPool) evictLocked() []api.Connection {
+	var expired []api.Connection
+	deadline := p.now().Add(-p.idleTimeout)
+	for modelUUID, conns := range p.idle {
+		// Connections are appended as they are released, so the
+		// oldest ones are at the start of the slice.
+		i := 0
+		for i < len(conns) && conns[i].since.Before(deadline) {
+			expired = append(expired, conns[i].conn)
+			i++
+		}
+		switch {
+		case i == len(conns):
+			delete(p.idle, modelUUID)
+		case i > 0:
+			p.idle[modelUUID] = append([]idleConnection(nil), conns[i:]...)
+		}
+	}
+	return expired
+}
+
+func closeConnections(conns []api.Connection) {
+	for _, conn := range conns {
+		_ = conn.Close()
+	}
+}
+
+// pooledConnection wraps a connection handed out by the pool so that
+// closing it releases the underlying connection back to the pool.
+type pooledConnection struct {
+	api.Connection
+
+	pool      *connectionPool
+	modelUUID string
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// Close releases the connection back to the pool. It is safe to call
+// more than once; only the first call has any effect.
+func (c *pooledConnection) Close() error {
+	c.closeOnce.Do(func() {
+		c.closeErr = c.pool.release(c.modelUUID, c.Connection)
+	})
+	return c.closeErr
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type ConnectionPoolSuite struct {
+	suite.Suite
+
+	ctlr  *gomock.Controller
+	dials map[string]int
+	now   time.Time
+	mu    sync.Mutex
+}
+
+func (s *ConnectionPoolSuite) SetupTest() {
+	s.ctlr = gomock.NewController(s.T())
+	s.dials = make(map[string]int)
+	s.now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// newConnection returns a healthy mock connection which expects to be
+// closed the given number of times.
+func (s *ConnectionPoolSuite) newConnection(closed int) *MockConnection {
+	conn := NewMockConnection(s.ctlr)
+	conn.EXPECT().Broken().Return(make(chan struct{})).AnyTimes()
+	conn.EXPECT().IsBroken().Return(false).AnyTimes()
+	conn.EXPECT().Close().Return(nil).Times(closed)
+	return conn
+}
+
+func (s *ConnectionPoolSuite) newPool(conns ...api.Connection) *connectionPool {
+	pool := newConnectionPool(func(modelUUID string) (api.Connection, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.dials[modelUUID]++
+		if len(conns) == 0 {
+			return nil, errors.New("no more connections")
+		}
+		conn := conns[0]
+		conns = conns[1:]
+		return conn, nil
+	})
+	pool.now = func() time.Time { return s.now }
+	return pool
+}
+
+func (s *ConnectionPoolSuite) TestGetReusesConnection() {
+	pool := s.newPool(s.newConnection(0))
+
+	for i := 0; i < 3; i++ {
+		conn, err := pool.Get("model-uuid")
+		s.Require().NoError(err)
+		s.Require().NoError(conn.Close())
+	}
+	s.Assert().Equal(1, s.dials["model-uuid"])
+}
+
+func (s *ConnectionPoolSuite) TestGetKeyedByModel() {
+	pool := s.newPool(s.newConnection(0), s.newConnection(0))
+
+	for _, modelUUID := range []string{"", "model-uuid", "", "model-uuid"} {
+		conn, err := pool.Get(modelUUID)
+		s.Require().NoError(err)
+		s.Require().NoError(conn.Close())
+	}
+	s.Assert().Equal(map[string]int{"": 1, "model-uuid": 1}, s.dials)
+}
+
+func (s *ConnectionPoolSuite) TestGetInUseConnectionNotShared() {
+	pool := s.newPool(s.newConnection(0), s.newConnection(0))
+
+	conn1, err := pool.Get("model-uuid")
+	s.Require().NoError(err)
+	conn2, err := pool.Get("model-uuid")
+	s.Require().NoError(err)
+	s.Assert().Equal(2, s.dials["model-uuid"])
+	s.Require().NoError(conn1.Close())
+	s.Require().NoError(conn2.Close())
+}
+
+func (s *ConnectionPoolSuite) TestGetDiscardsBrokenConnection() {
+	broken := NewMockConnection(s.ctlr)
+	broken.EXPECT().Broken().Return(make(chan struct{}))
+	broken.EXPECT().IsBroken().Return(true)
+	broken.EXPECT().Close().Return(nil)
+	pool := s.newPool(broken, s.newConnection(0))
+
+	conn, err := pool.Get("model-uuid")
+	s.Require().NoError(err)
+	s.Require().NoError(conn.Close())
+
+	conn, err = pool.Get("model-uuid")
+	s.Require().NoError(err)
+	s.Require().NoError(conn.Close())
+	s.Assert().Equal(2, s.dials["model-uuid"])
+}
+
+func (s *ConnectionPoolSuite) TestReleaseClosesBrokenConnection() {
+	brokenCh := make(chan struct{})
+	close(brokenCh)
+	broken := NewMockConnection(s.ctlr)
+	broken.EXPECT().Broken().Return(brokenCh)
+	broken.EXPECT().Close().Return(nil)
+	pool := s.newPool(broken)
+
+	conn, err := pool.Get("model-uuid")
+	s.Require().NoError(err)
+	s.Require().NoError(conn.Close())
+	s.Assert().Empty(pool.idle)
+}
+
+func (s *ConnectionPoolSuite) TestReleaseClosesOnlyOnce() {
+	pool := s.newPool(s.newConnection(0))
+
+	conn, err := pool.Get("model-uuid")
+	s.Require().NoError(err)
+	s.Require().NoError(conn.Close())
+	s.Require().NoError(conn.Close())
+	s.Assert().Len(pool.idle["model-uuid"], 1)
+}
+
+func (s *ConnectionPoolSuite) TestReleaseClosesBeyondMaxIdle() {
+	pool := s.newPool(s.newConnection(0), s.newConnection(1))
+	pool.maxIdle = 1
+
+	conn1, err := pool.Get("model-uuid")
+	s.Require().NoError(err)
+	conn2, err := pool.Get("model-uuid")
+	s.Require().NoError(err)
+	s.Require().NoError(conn1.Close())
+	s.Require().NoError(conn2.Close())
+	s.Assert().Len(pool.idle["model-uuid"], 1)
+}
+
+func (s *ConnectionPoolSuite) TestIdleConnectionEvicted() {
+	pool := s.newPool(s.newConnection(1), s.newConnection(0))
+
+	conn, err := pool.Get("model-uuid")
+	s.Require().NoError(err)
+	s.Require().NoError(conn.Close())
+
+	s.now = s.now.Add(defaultConnectionIdleTimeout + time.Second)
+	conn, err = pool.Get("model-uuid")
+	s.Require().NoError(err)
+	s.Require().NoError(conn.Close())
+	s.Assert().Equal(2, s.dials["model-uuid"])
+}
+
+func (s *ConnectionPoolSuite) TestGetDialError() {
+	pool := s.newPool()
+
+	_, err := pool.Get("model-uuid")
+	s.Require().Error(err)
+	s.Assert().Empty(pool.idle)
+}
+
+func (s *ConnectionPoolSuite) TestConcurrentUse() {
+	var conns []api.Connection
+	for i := 0; i < 10; i++ {
+		conns = append(conns, s.newConnection(0))
+	}
+	pool := s.newPool(conns...)
+	pool.maxIdle = len(conns)
+
+	var wg sync.WaitGroup
+	for i := 0; i < len(conns); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				conn, err := pool.Get("model-uuid")
+				if !s.Assert().NoError(err) {
+					return
+				}
+				s.Assert().NoError(conn.Close())
+			}
+		}()
+	}
+	wg.Wait()
+	s.Assert().LessOrEqual(s.dials["model-uuid"], len(conns))
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestConnectionPoolSuite(t *testing.T) {
+	suite.Run(t, new(ConnectionPoolSuite))
+}
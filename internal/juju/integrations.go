@@ -12,7 +12,10 @@ import (
 	"time"
 
 	"github.com/juju/errors"
+	"github.com/juju/juju/api"
 	apiapplication "github.com/juju/juju/api/client/application"
+	apiclient "github.com/juju/juju/api/client/client"
+	corestatus "github.com/juju/juju/core/status"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -54,6 +57,10 @@ type IntegrationInput struct {
 	Apps      []string
 	Endpoints []string
 	ViaCIDRs  string
+	// AppAvailableTimeout is the time to wait for the applications to
+	// be available before integrating them. IntegrationAppAvailableTimeout
+	// is used if zero.
+	AppAvailableTimeout time.Duration
 }
 
 type CreateIntegrationResponse struct {
@@ -62,6 +69,10 @@ type CreateIntegrationResponse struct {
 
 type ReadIntegrationResponse struct {
 	Applications []Application
+	// Suspended is true if the integration is suspended, or being
+	// suspended, with the reason in SuspendedReason.
+	Suspended       bool
+	SuspendedReason string
 }
 
 type UpdateIntegrationResponse struct {
@@ -75,6 +86,17 @@ type UpdateIntegrationInput struct {
 	ViaCIDRs     string
 }
 
+// SetIntegrationSuspendedInput represents input for suspending or
+// resuming an integration.
+type SetIntegrationSuspendedInput struct {
+	ModelUUID string
+	Endpoints []string
+	Suspended bool
+	// Reason is the reason the integration is suspended. It is ignored
+	// when resuming the integration.
+	Reason string
+}
+
 func newIntegrationsClient(sc SharedClient) *integrationsClient {
 	return &integrationsClient{
 		SharedClient: sc,
@@ -90,11 +112,22 @@ func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateI
 
 	client := apiapplication.NewClient(conn)
 
+	// Consumed offers (SAAS) are not known by ApplicationsInfo, only
+	// wait for the local applications.
+	apps, err := c.localApplications(conn, input.Apps)
+	if err != nil {
+		return nil, err
+	}
+
 	// wait for the apps to be available
-	ctx, cancel := context.WithTimeout(context.Background(), IntegrationAppAvailableTimeout)
+	timeout := input.AppAvailableTimeout
+	if timeout == 0 {
+		timeout = IntegrationAppAvailableTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	err = WaitForAppsAvailable(ctx, client, input.Apps, IntegrationApiTickWait)
+	err = WaitForAppsAvailable(ctx, client, apps, IntegrationApiTickWait)
 	if err != nil {
 		return nil, errors.New("the applications were not available to be integrated")
 	}
@@ -125,6 +158,25 @@ func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateI
 	}, nil
 }
 
+// localApplications returns the given application names which are not
+// consumed offers (SAAS) in the model.
+func (c integrationsClient) localApplications(conn api.Connection, appNames []string) ([]string, error) {
+	if len(appNames) == 0 {
+		return nil, nil
+	}
+	status, err := apiclient.NewClient(conn, c.JujuLogger()).Status(&apiclient.StatusArgs{Patterns: appNames})
+	if err != nil {
+		return nil, errors.Annotate(err, "fetching status of applications to integrate")
+	}
+	apps := make([]string, 0, len(appNames))
+	for _, name := range appNames {
+		if _, ok := status.RemoteApplications[name]; !ok {
+			apps = append(apps, name)
+		}
+	}
+	return apps, nil
+}
+
 func (c integrationsClient) ReadIntegration(input *IntegrationInput) (*ReadIntegrationResponse, error) {
 	conn, err := c.GetConnection(&input.ModelUUID)
 	if err != nil {
@@ -140,14 +192,103 @@ func (c integrationsClient) ReadIntegration(input *IntegrationInput) (*ReadInteg
 		return nil, err
 	}
 
-	integrations := status.Relations
+	integration, err := findIntegration(status.Relations, input.Endpoints, modelUUID.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	applications, err := parseApplications(status.RemoteApplications, integration.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadIntegrationResponse{
+		Applications: applications,
+	}
+	switch corestatus.Status(integration.Status.Status) {
+	case corestatus.Suspended, corestatus.Suspending:
+		response.Suspended = true
+		response.SuspendedReason = integration.Status.Info
+	}
+	return response, nil
+}
+
+// ListIntegrations returns the applications of each integration in the
+// model, with the provider first. Peer integrations are not included.
+func (c integrationsClient) ListIntegrations(modelUUID string) ([][]Application, error) {
+	conn, err := c.GetConnection(&modelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.ModelStatus(modelUUID, conn)
+	if err != nil {
+		return nil, errors.Annotatef(err, "fetching status of model %q", modelUUID)
+	}
+	integrations := make([][]Application, 0, len(status.Relations))
+	for _, relation := range status.Relations {
+		if len(relation.Endpoints) != 2 {
+			continue
+		}
+		apps := make([]Application, 0, 2)
+		for _, endpoint := range relation.Endpoints {
+			app := Application{
+				Name:     endpoint.ApplicationName,
+				Endpoint: endpoint.Name,
+				Role:     endpoint.Role,
+			}
+			if endpoint.Role == "provider" {
+				apps = append([]Application{app}, apps...)
+			} else {
+				apps = append(apps, app)
+			}
+		}
+		integrations = append(integrations, apps)
+	}
+	return integrations, nil
+}
+
+// SetIntegrationSuspended suspends or resumes an integration. Juju only
+// allows suspending cross-model integrations, from the model hosting the
+// offer.
+func (c integrationsClient) SetIntegrationSuspended(input *SetIntegrationSuspendedInput) error {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := apiclient.NewClient(conn, c.JujuLogger()).Status(nil)
+	if err != nil {
+		return errors.Annotatef(err, "fetching status of model %q", input.ModelUUID)
+	}
+	integration, err := findIntegration(status.Relations, input.Endpoints, input.ModelUUID)
+	if err != nil {
+		return err
+	}
+
+	reason := input.Reason
+	if !input.Suspended {
+		reason = ""
+	}
+	client := apiapplication.NewClient(conn)
+	if err := client.SetRelationSuspended([]int{integration.Id}, input.Suspended, reason); err != nil {
+		return errors.Annotatef(err, "setting integration %q suspended to %t", integration.Key, input.Suspended)
+	}
+	return nil
+}
+
+// findIntegration returns the integration with the given endpoints, of
+// the form "<provider>:<endpoint>" and "<requirer>:<endpoint>".
+func findIntegration(integrations []params.RelationStatus, endpoints []string, modelUUID string) (params.RelationStatus, error) {
 	var integration params.RelationStatus
 	if len(integrations) == 0 {
-		return nil, NewIntegrationNotFoundError(modelUUID.Id())
+		return integration, NewIntegrationNotFoundError(modelUUID)
 	}
 
-	apps := make([][]string, 0, len(input.Endpoints))
-	for _, v := range input.Endpoints {
+	apps := make([][]string, 0, len(endpoints))
+	for _, v := range endpoints {
 		app := strings.Split(v, ":")
 		apps = append(apps, []string{
 			app[0],
@@ -170,23 +311,15 @@ func (c integrationsClient) ReadIntegration(input *IntegrationInput) (*ReadInteg
 		keyReversed := fmt.Sprintf("%v:%v %v:%v", apps[1][0], apps[1][1], apps[0][0], apps[0][1])
 		for _, v := range integrations {
 			if v.Key == keyReversed {
-				return nil, fmt.Errorf("check the endpoint order in your ID")
+				return integration, fmt.Errorf("check the endpoint order in your ID")
 			}
 		}
 	}
 
 	if integration.Id == 0 && integration.Key == "" {
-		return nil, NewIntegrationNotFoundError(modelUUID.Id())
+		return integration, NewIntegrationNotFoundError(modelUUID)
 	}
-
-	applications, err := parseApplications(status.RemoteApplications, integration.Endpoints)
-	if err != nil {
-		return nil, err
-	}
-
-	return &ReadIntegrationResponse{
-		Applications: applications,
-	}, nil
+	return integration, nil
 }
 
 func (c integrationsClient) DestroyIntegration(input *IntegrationInput) error {
@@ -0,0 +1,56 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/suite"
+)
+
+type IntegrationSuite struct {
+	suite.Suite
+	JujuSuite
+
+	testModelUUID string
+}
+
+func (s *IntegrationSuite) SetupSuite() {
+	s.testModelUUID = "fff19e46-8a0b-4a52-8e1d-7b16a40f8f2a"
+	s.testModelName = &s.testModelUUID
+}
+
+func (s *IntegrationSuite) TestListIntegrations() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockSharedClient.EXPECT().ModelStatus(s.testModelUUID, s.mockConnection).Return(&params.FullStatus{
+		Relations: []params.RelationStatus{
+			{
+				Key: "wordpress:db mysql:database",
+				Endpoints: []params.EndpointStatus{
+					{ApplicationName: "wordpress", Name: "db", Role: "requirer"},
+					{ApplicationName: "mysql", Name: "database", Role: "provider"},
+				},
+			},
+			{
+				Key:       "mysql:cluster",
+				Endpoints: []params.EndpointStatus{{ApplicationName: "mysql", Name: "cluster", Role: "peer"}},
+			},
+		},
+	}, nil)
+	client := newIntegrationsClient(s.mockSharedClient)
+
+	integrations, err := client.ListIntegrations(s.testModelUUID)
+	s.Require().NoError(err)
+	s.Assert().Equal([][]Application{{
+		{Name: "mysql", Endpoint: "database", Role: "provider"},
+		{Name: "wordpress", Endpoint: "db", Role: "requirer"},
+	}}, integrations, "the provider is listed first and peer integrations are not listed")
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationSuite))
+}
@@ -10,18 +10,22 @@ import (
 	"github.com/juju/charm/v12"
 	charmresources "github.com/juju/charm/v12/resource"
 	"github.com/juju/juju/api"
+	apiaction "github.com/juju/juju/api/client/action"
 	apiapplication "github.com/juju/juju/api/client/application"
 	apiclient "github.com/juju/juju/api/client/client"
 	apiresources "github.com/juju/juju/api/client/resources"
+	apisecretbackends "github.com/juju/juju/api/client/secretbackends"
 	apisecrets "github.com/juju/juju/api/client/secrets"
 	apicommoncharm "github.com/juju/juju/api/common/charm"
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/crossmodel"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/resources"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v5"
+	"github.com/juju/version/v2"
 )
 
 type SharedClient interface {
@@ -30,6 +34,7 @@ type SharedClient interface {
 	ModelType(modelUUID string) (model.ModelType, error)
 	ModelOwnerAndName(modelUUID string) (string, string, error)
 	ModelStatus(modelUUID string, conn api.Connection) (*params.FullStatus, error)
+	ModelSnapshot(modelUUID string) (*ModelSnapshot, bool)
 	RemoveModel(modelUUID string)
 	// ModelUUID returns a model's UUID based on the model name and owner.
 	// Specifying the owner is optional but recommended. See the docstring
@@ -50,6 +55,7 @@ type ClientAPIClient interface {
 }
 
 type ApplicationAPIClient interface {
+	AddRelation(endpoints, viaCIDRs []string) (*params.AddRelationResults, error)
 	AddUnits(args apiapplication.AddUnitsParams) ([]string, error)
 	ApplicationsInfo(applications []names.ApplicationTag) ([]params.ApplicationInfoResult, error)
 	Deploy(args apiapplication.DeployArgs) error
@@ -59,6 +65,7 @@ type ApplicationAPIClient interface {
 	Expose(application string, exposedEndpoints map[string]params.ExposedEndpoint) error
 	Get(branchName, application string) (*params.ApplicationGetResults, error)
 	GetCharmURLOrigin(branchName, applicationName string) (*charm.URL, apicommoncharm.Origin, error)
+	GetConfig(branchName string, appNames ...string) ([]map[string]interface{}, error)
 	GetConstraints(applications ...string) ([]constraints.Value, error)
 	MergeBindings(req params.ApplicationMergeBindingsArgs) error
 	ScaleApplication(in apiapplication.ScaleApplicationParams) (params.ScaleApplicationResult, error)
@@ -70,7 +77,27 @@ type ApplicationAPIClient interface {
 }
 
 type ModelConfigAPIClient interface {
+	GetModelConstraints() (constraints.Value, error)
 	ModelGet() (map[string]interface{}, error)
+	Sequences() (map[string]int, error)
+}
+
+// MachineManagerAPIClient defines the set of methods used to manage machines.
+type MachineManagerAPIClient interface {
+	AddMachines(machineParams []params.AddMachineParams) ([]params.AddMachinesResult, error)
+}
+
+// ApplicationOffersAPIClient defines the set of methods used to manage offers.
+type ApplicationOffersAPIClient interface {
+	ApplicationOffer(urlStr string) (*crossmodel.ApplicationOfferDetails, error)
+	DestroyOffers(force bool, offerURLs ...string) error
+	GrantOffer(user, access string, offerURLs ...string) error
+	Offer(modelUUID, application string, endpoints []string, owner, offerName, desc string) ([]params.ErrorResult, error)
+}
+
+// LocalCharmAPIClient defines the set of methods used to upload local charms.
+type LocalCharmAPIClient interface {
+	AddLocalCharm(curl *charm.URL, ch charm.Charm, force bool, agentVersion version.Number) (*charm.URL, error)
 }
 
 type ResourceAPIClient interface {
@@ -92,6 +119,29 @@ type SecretAPIClient interface {
 	RevokeSecret(uri *secrets.URI, name string, apps []string) ([]error, error)
 }
 
+// SecretBackendAPIClient defines the set of methods that the secret backends API provides.
+type SecretBackendAPIClient interface {
+	ListSecretBackends(names []string, reveal bool) ([]apisecretbackends.SecretBackend, error)
+	AddSecretBackend(backend apisecretbackends.CreateSecretBackend) error
+	UpdateSecretBackend(arg apisecretbackends.UpdateSecretBackend, force bool) error
+	RemoveSecretBackend(name string, force bool) error
+}
+
+// SpaceAPIClient defines the set of methods that the spaces API provides.
+type SpaceAPIClient interface {
+	CreateSpace(name string, cidrs []string, public bool) error
+	ShowSpace(name string) (params.ShowSpaceResult, error)
+	RenameSpace(oldName string, newName string) error
+	RemoveSpace(name string, force bool, dryRun bool) (params.RemoveSpaceResult, error)
+	MoveSubnets(space names.SpaceTag, subnets []names.SubnetTag, force bool) (params.MoveSubnetsResult, error)
+}
+
+// SubnetAPIClient defines the set of methods that the subnets API provides.
+type SubnetAPIClient interface {
+	ListSubnets(spaceTag *names.SpaceTag, zone string) ([]params.Subnet, error)
+	SubnetsByCIDR(cidrs []string) ([]params.SubnetsResult, error)
+}
+
 // JaasAPIClient defines the set of methods that the JAAS API provides.
 type JaasAPIClient interface {
 	ListRelationshipTuples(req *jaasparams.ListRelationshipTuplesRequest) (*jaasparams.ListRelationshipTuplesResponse, error)
@@ -105,6 +155,9 @@ type JaasAPIClient interface {
 	GetRole(req *jaasparams.GetRoleRequest) (jaasparams.GetRoleResponse, error)
 	RenameRole(req *jaasparams.RenameRoleRequest) error
 	RemoveRole(req *jaasparams.RemoveRoleRequest) error
+	AddServiceAccount(req *jaasparams.AddServiceAccountRequest) error
+	CopyServiceAccountCredential(req *jaasparams.CopyServiceAccountCredentialRequest) (*params.UpdateCredentialResult, error)
+	ListServiceAccountCredentials(req *jaasparams.ListServiceAccountCredentialsRequest) (*params.CredentialContentResults, error)
 }
 
 // KubernetesCloudAPIClient defines the set of methods that the Kubernetes cloud API provides.
@@ -122,3 +175,10 @@ type AnnotationsAPIClient interface {
 	Get(tags []string) ([]params.AnnotationsGetResult, error)
 	Set(annotations map[string]map[string]string) ([]params.ErrorResult, error)
 }
+
+// ActionAPIClient defines the set of methods used to run charm actions.
+type ActionAPIClient interface {
+	ApplicationCharmActions(appName string) (map[string]apiaction.ActionSpec, error)
+	EnqueueOperation(actions []apiaction.Action) (apiaction.EnqueuedActions, error)
+	Operation(ID string) (apiaction.Operation, error)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/canonical/jimm-go-sdk/v3/api"
 	"github.com/canonical/jimm-go-sdk/v3/api/params"
 	jujuapi "github.com/juju/juju/api"
+	jujuparams "github.com/juju/juju/rpc/params"
 )
 
 type jaasClient struct {
@@ -292,3 +293,86 @@ func (jc *jaasClient) RemoveRole(name string) error {
 	req := params.RemoveRoleRequest{Name: name}
 	return client.RemoveRole(&req)
 }
+
+// JaasCredential identifies a cloud credential held by JAAS.
+type JaasCredential struct {
+	Cloud string
+	Name  string
+}
+
+// AddServiceAccount attempts to bind the service account with the provided
+// client ID to the current user, allowing them to manage it.
+func (jc *jaasClient) AddServiceAccount(clientID string) error {
+	conn, err := jc.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	req := params.AddServiceAccountRequest{ClientID: clientID}
+	return client.AddServiceAccount(&req)
+}
+
+// CopyServiceAccountCredentials attempts to copy the provided cloud
+// credentials, owned by the current user, to the service account with
+// the provided client ID.
+func (jc *jaasClient) CopyServiceAccountCredentials(clientID string, credentials []JaasCredential) error {
+	conn, err := jc.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	for _, credential := range credentials {
+		req := params.CopyServiceAccountCredentialRequest{
+			CloudCredentialArg: jujuparams.CloudCredentialArg{
+				CloudName:      credential.Cloud,
+				CredentialName: credential.Name,
+			},
+			ClientID: clientID,
+		}
+		resp, err := client.CopyServiceAccountCredential(&req)
+		if err != nil {
+			return err
+		}
+		if resp.Error != nil {
+			return resp.Error
+		}
+	}
+	return nil
+}
+
+// ReadServiceAccountCredentials attempts to read the cloud credentials
+// belonging to the service account with the provided client ID.
+func (jc *jaasClient) ReadServiceAccountCredentials(clientID string) ([]JaasCredential, error) {
+	conn, err := jc.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	// An empty list of credentials requests all of those belonging
+	// to the service account.
+	req := params.ListServiceAccountCredentialsRequest{ClientID: clientID}
+	resp, err := client.ListServiceAccountCredentials(&req)
+	if err != nil {
+		return nil, err
+	}
+	credentials := make([]JaasCredential, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.Result == nil {
+			continue
+		}
+		credentials = append(credentials, JaasCredential{
+			Cloud: result.Result.Content.Cloud,
+			Name:  result.Result.Content.Name,
+		})
+	}
+	return credentials, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/canonical/jimm-go-sdk/v3/api/params"
 	"github.com/juju/juju/api"
+	jujuparams "github.com/juju/juju/rpc/params"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
@@ -293,6 +294,87 @@ func (s *JaasSuite) TestRemoveRole() {
 	s.Require().NoError(err)
 }
 
+func (s *JaasSuite) TestAddServiceAccount() {
+	defer s.setupMocks(s.T()).Finish()
+
+	clientID := "client-id"
+	req := &params.AddServiceAccountRequest{ClientID: clientID}
+	s.mockJaasClient.EXPECT().AddServiceAccount(req).Return(nil)
+
+	client := s.getJaasClient()
+	err := client.AddServiceAccount(clientID)
+	s.Require().NoError(err)
+}
+
+func (s *JaasSuite) TestCopyServiceAccountCredentials() {
+	defer s.setupMocks(s.T()).Finish()
+
+	clientID := "client-id"
+	credentials := []JaasCredential{
+		{Cloud: "aws", Name: "cred-1"},
+		{Cloud: "gce", Name: "cred-2"},
+	}
+	for _, credential := range credentials {
+		req := &params.CopyServiceAccountCredentialRequest{
+			CloudCredentialArg: jujuparams.CloudCredentialArg{
+				CloudName:      credential.Cloud,
+				CredentialName: credential.Name,
+			},
+			ClientID: clientID,
+		}
+		s.mockJaasClient.EXPECT().CopyServiceAccountCredential(req).Return(&jujuparams.UpdateCredentialResult{}, nil)
+	}
+
+	client := s.getJaasClient()
+	err := client.CopyServiceAccountCredentials(clientID, credentials)
+	s.Require().NoError(err)
+}
+
+func (s *JaasSuite) TestCopyServiceAccountCredentialsResultError() {
+	defer s.setupMocks(s.T()).Finish()
+
+	clientID := "client-id"
+	req := &params.CopyServiceAccountCredentialRequest{
+		CloudCredentialArg: jujuparams.CloudCredentialArg{
+			CloudName:      "aws",
+			CredentialName: "cred",
+		},
+		ClientID: clientID,
+	}
+	resp := &jujuparams.UpdateCredentialResult{Error: &jujuparams.Error{Message: "credential not found"}}
+	s.mockJaasClient.EXPECT().CopyServiceAccountCredential(req).Return(resp, nil)
+
+	client := s.getJaasClient()
+	err := client.CopyServiceAccountCredentials(clientID, []JaasCredential{{Cloud: "aws", Name: "cred"}})
+	s.Require().ErrorContains(err, "credential not found")
+}
+
+func (s *JaasSuite) TestReadServiceAccountCredentials() {
+	defer s.setupMocks(s.T()).Finish()
+
+	clientID := "client-id"
+	req := &params.ListServiceAccountCredentialsRequest{ClientID: clientID}
+	resp := &jujuparams.CredentialContentResults{
+		Results: []jujuparams.CredentialContentResult{
+			{Result: &jujuparams.ControllerCredentialInfo{
+				Content: jujuparams.CredentialContent{Cloud: "aws", Name: "cred-1"},
+			}},
+			{Result: &jujuparams.ControllerCredentialInfo{
+				Content: jujuparams.CredentialContent{Cloud: "gce", Name: "cred-2"},
+			}},
+		},
+	}
+	s.mockJaasClient.EXPECT().ListServiceAccountCredentials(req).Return(resp, nil)
+
+	client := s.getJaasClient()
+	credentials, err := client.ReadServiceAccountCredentials(clientID)
+	s.Require().NoError(err)
+	s.Require().Equal([]JaasCredential{
+		{Cloud: "aws", Name: "cred-1"},
+		{Cloud: "gce", Name: "cred-2"},
+	}, credentials)
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestJaasSuite(t *testing.T) {
@@ -54,6 +54,7 @@ type ReadKubernetesCloudOutput struct {
 	CredentialName    string
 	ParentCloudName   string
 	ParentCloudRegion string
+	StorageClassName  string
 }
 
 type UpdateKubernetesCloudInput struct {
@@ -211,11 +212,15 @@ func (c *kubernetesCloudsClient) ReadKubernetesCloud(input ReadKubernetesCloudIn
 	credentialName := cloudCredentialTags[0].Name()
 
 	parentCloudName, parentCloudRegion := getParentCloudNameAndRegion(cld.HostCloudRegion)
+	// The storage class name is set for both operator and workload storage,
+	// see CreateKubernetesCloud.
+	storageClassName, _ := cld.Config[workloadStorageKey].(string)
 	return &ReadKubernetesCloudOutput{
 		Name:              input.Name,
 		CredentialName:    credentialName,
 		ParentCloudName:   parentCloudName,
 		ParentCloudRegion: parentCloudRegion,
+		StorageClassName:  storageClassName,
 	}, nil
 }
 
@@ -6,8 +6,11 @@ package juju
 import (
 	"testing"
 
+	"github.com/juju/juju/api"
 	k8s "github.com/juju/juju/caas/kubernetes"
 	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/names/v5"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 	"k8s.io/client-go/tools/clientcmd"
@@ -130,6 +133,42 @@ func (s *KubernetesCloudSuite) TestRemoveKubernetesCloud() {
 	s.Require().NoError(err)
 }
 
+func (s *KubernetesCloudSuite) TestReadKubernetesCloud() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	s.mockSharedClient.EXPECT().GetConnection(nil).Return(s.mockConnection, nil)
+	s.mockConnection.EXPECT().AuthTag().Return(names.NewUserTag("admin"))
+
+	cloudTag := names.NewCloudTag("fake-cloud")
+	s.mockKubernetesCloudClient.EXPECT().Cloud(cloudTag).Return(jujucloud.Cloud{
+		Name:            "fake-cloud",
+		HostCloudRegion: "aws/us-east-1",
+		Config: map[string]interface{}{
+			operatorStorageKey: "gp2",
+			workloadStorageKey: "gp2",
+		},
+	}, nil)
+	s.mockKubernetesCloudClient.EXPECT().UserCredentials(names.NewUserTag("admin"), cloudTag).Return(
+		[]names.CloudCredentialTag{names.NewCloudCredentialTag("fake-cloud/admin/fake-cloud")}, nil)
+
+	client := kubernetesCloudsClient{
+		SharedClient: s.mockSharedClient,
+		getKubernetesCloudAPIClient: func(api.Connection) KubernetesCloudAPIClient {
+			return s.mockKubernetesCloudClient
+		},
+	}
+	output, err := client.ReadKubernetesCloud(ReadKubernetesCloudInput{Name: "fake-cloud"})
+	s.Require().NoError(err)
+	s.Require().Equal(&ReadKubernetesCloudOutput{
+		Name:              "fake-cloud",
+		CredentialName:    "fake-cloud",
+		ParentCloudName:   "aws",
+		ParentCloudRegion: "us-east-1",
+		StorageClassName:  "gp2",
+	}, output)
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestKubernetesCloudSuite(t *testing.T) {
@@ -0,0 +1,316 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/juju/charm/v12"
+	charmresources "github.com/juju/charm/v12/resource"
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apiapplication "github.com/juju/juju/api/client/application"
+	apicommoncharm "github.com/juju/juju/api/common/charm"
+	"github.com/juju/juju/cmd/juju/application/utils"
+	corebase "github.com/juju/juju/core/base"
+	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/version/v2"
+)
+
+// LocalCharmHash returns a hex encoded sha256 hash of the charm found at
+// path, which can either be a charm archive (.charm file) or an unpacked
+// charm directory.
+//
+// For an archive, the hash is computed over the archive itself. For a
+// directory, the hash is computed over the relative path, mode and content
+// of every regular file and symlink in the directory, walked in lexical
+// order, so that the result is stable across runs and hosts. Any change to
+// the files of the charm results in a different hash.
+func LocalCharmHash(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", jujuerrors.Trace(err)
+	}
+	hasher := sha256.New()
+	if !info.IsDir() {
+		f, err := os.Open(path)
+		if err != nil {
+			return "", jujuerrors.Trace(err)
+		}
+		defer func() { _ = f.Close() }()
+		if _, err := io.Copy(hasher, f); err != nil {
+			return "", jujuerrors.Annotatef(err, "hashing charm archive %q", path)
+		}
+		return hex.EncodeToString(hasher.Sum(nil)), nil
+	}
+
+	// filepath.WalkDir walks files in lexical order.
+	err = filepath.WalkDir(path, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(path, filePath)
+		if err != nil {
+			return err
+		}
+		fileInfo, err := d.Info()
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(hasher, "%s\x00%o\x00", filepath.ToSlash(relPath), fileInfo.Mode().Perm())
+		if fileInfo.Mode()&fs.ModeSymlink != 0 {
+			target, err := os.Readlink(filePath)
+			if err != nil {
+				return err
+			}
+			_, _ = hasher.Write([]byte(target))
+			return nil
+		}
+		if !fileInfo.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		_, err = io.Copy(hasher, f)
+		return err
+	})
+	if err != nil {
+		return "", jujuerrors.Annotatef(err, "hashing charm directory %q", path)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// readLocalCharm reads the charm archive or directory found at path and
+// returns it together with the local charm URL to use when uploading it.
+func readLocalCharm(path string) (charm.Charm, *charm.URL, error) {
+	ch, err := charm.ReadCharm(path)
+	if err != nil {
+		return nil, nil, jujuerrors.Annotatef(err, "reading local charm at %q", path)
+	}
+	curl := &charm.URL{
+		Schema:   charm.Local.String(),
+		Name:     ch.Meta().Name,
+		Revision: ch.Revision(),
+	}
+	return ch, curl, nil
+}
+
+// localCharmBase selects the base to deploy a local charm with. A user
+// supplied base must be one of the bases supported by the charm, otherwise
+// the first base declared by the charm is used.
+func localCharmBase(ch charm.Charm, userSuppliedBase corebase.Base) (corebase.Base, error) {
+	charmBases, err := corecharm.ComputedBases(ch)
+	if err != nil {
+		return corebase.Base{}, jujuerrors.Trace(err)
+	}
+	if !userSuppliedBase.Empty() {
+		if basesContain(userSuppliedBase, charmBases) {
+			return userSuppliedBase, nil
+		}
+		return corebase.Base{}, jujuerrors.NewNotSupported(nil,
+			fmt.Sprintf("base %q is not supported by the local charm %q", userSuppliedBase, ch.Meta().Name))
+	}
+	if len(charmBases) == 0 {
+		return corebase.Base{}, jujuerrors.NotValidf("local charm %q with no bases", ch.Meta().Name)
+	}
+	return charmBases[0], nil
+}
+
+// validateLocalCharmResources ensures that every resource required by a
+// local charm is provided as an OCI image. Local charms have no store
+// to fetch resources from, so they have to be uploaded by the provider.
+func validateLocalCharmResources(meta map[string]charmresources.Meta, resourcesToUse map[string]CharmResource) error {
+	names := make([]string, 0, len(meta))
+	for name := range meta {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		resource, ok := resourcesToUse[name]
+		if !ok {
+			return fmt.Errorf("local charms require resource %q to be specified", name)
+		}
+		if resource.OCIImageURL == "" {
+			return fmt.Errorf("local charms require resource %q to be specified as an OCI image", name)
+		}
+	}
+	return nil
+}
+
+// localCharmAgentVersion returns the version the min-juju-version of a
+// local charm is checked against when it is uploaded. It is the version
+// of the controller, or the agent version of the model when the
+// connection does not know the version of the controller.
+func localCharmAgentVersion(conn api.Connection, modelConfigAPIClient ModelConfigAPIClient) (version.Number, error) {
+	if agentVersion, ok := conn.ServerVersion(); ok {
+		return agentVersion, nil
+	}
+	attrs, err := modelConfigAPIClient.ModelGet()
+	if err != nil {
+		return version.Zero, jujuerrors.Annotate(err, "cannot fetch model settings")
+	}
+	modelConfig, err := config.New(config.NoDefaults, attrs)
+	if err != nil {
+		return version.Zero, jujuerrors.Trace(err)
+	}
+	agentVersion, ok := modelConfig.AgentVersion()
+	if !ok {
+		return version.Zero, jujuerrors.New("cannot determine the agent version of the model")
+	}
+	return agentVersion, nil
+}
+
+// deployLocalCharm uploads the local charm found at transformedInput.charmPath
+// to the controller and deploys it as a new application.
+func (c applicationsClient) deployLocalCharm(
+	applicationAPIClient ApplicationAPIClient,
+	localCharmAPIClient LocalCharmAPIClient,
+	modelConfigAPIClient ModelConfigAPIClient,
+	resourceAPIClient ResourceAPIClient,
+	agentVersion version.Number,
+	transformedInput transformedCreateApplicationInput,
+) error {
+	ch, curl, err := readLocalCharm(transformedInput.charmPath)
+	if err != nil {
+		return err
+	}
+	base, err := localCharmBase(ch, transformedInput.charmBase)
+	if err != nil {
+		return err
+	}
+	if err := validateLocalCharmResources(ch.Meta().Resources, transformedInput.resources); err != nil {
+		return err
+	}
+	modelConstraints, err := modelConfigAPIClient.GetModelConstraints()
+	if err != nil {
+		return err
+	}
+
+	c.Tracef("Uploading local charm", map[string]interface{}{"path": transformedInput.charmPath, "url": curl.String()})
+	uploadedURL, err := localCharmAPIClient.AddLocalCharm(curl, ch, false, agentVersion)
+	if err != nil {
+		return jujuerrors.Annotate(typedError(err), "uploading local charm")
+	}
+
+	platform := utils.MakePlatform(transformedInput.constraints, base, modelConstraints)
+	origin, err := utils.MakeOrigin(charm.Local, uploadedURL.Revision, charm.Channel{}, platform)
+	if err != nil {
+		return err
+	}
+	charmID := apiapplication.CharmID{
+		URL:    uploadedURL.String(),
+		Origin: origin,
+	}
+
+	var resourceIDs map[string]string
+	if len(ch.Meta().Resources) > 0 {
+		resourceIDs, err = addPendingResources(transformedInput.applicationName, ch.Meta().Resources, transformedInput.resources, charmID, resourceAPIClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	units := transformedInput.units
+	if ch.Meta().Subordinate {
+		units = 0
+	}
+
+	appConfig := transformedInput.config
+	if appConfig == nil {
+		appConfig = make(map[string]string)
+	}
+	appConfig["trust"] = fmt.Sprintf("%v", transformedInput.trust)
+
+	args := apiapplication.DeployArgs{
+		CharmID:          charmID,
+		ApplicationName:  transformedInput.applicationName,
+		NumUnits:         units,
+		CharmOrigin:      origin,
+		Config:           appConfig,
+		Cons:             transformedInput.constraints,
+		Resources:        resourceIDs,
+		Storage:          transformedInput.storage,
+		Placement:        transformedInput.placement,
+		EndpointBindings: transformedInput.endpointBindings,
+	}
+	c.Tracef("Calling Deploy for local charm", map[string]interface{}{"args": args})
+	if err := applicationAPIClient.Deploy(args); err != nil {
+		return typedError(err)
+	}
+	return nil
+}
+
+// refreshLocalCharm uploads the local charm found at input.CharmPath and
+// refreshes the application to use it. The origin of the currently deployed
+// charm is kept, so that the platform of the application does not change.
+func (c applicationsClient) refreshLocalCharm(
+	input *UpdateApplicationInput,
+	applicationAPIClient ApplicationAPIClient,
+	localCharmAPIClient LocalCharmAPIClient,
+	agentVersion version.Number,
+) error {
+	ch, curl, err := readLocalCharm(input.CharmPath)
+	if err != nil {
+		return err
+	}
+	_, origin, err := applicationAPIClient.GetCharmURLOrigin("", input.AppName)
+	if err != nil {
+		return err
+	}
+	if !basesContainCharmBases(origin.Base, ch) {
+		return fmt.Errorf("the new local charm does not support the current operating system %q", origin.Base.String())
+	}
+
+	c.Tracef("Uploading local charm for refresh", map[string]interface{}{"path": input.CharmPath, "url": curl.String()})
+	uploadedURL, err := localCharmAPIClient.AddLocalCharm(curl, ch, false, agentVersion)
+	if err != nil {
+		return jujuerrors.Annotate(typedError(err), "uploading local charm")
+	}
+
+	// Local charms have no channel nor store identity, only the
+	// revision assigned by the controller on upload.
+	revision := uploadedURL.Revision
+	newOrigin := apicommoncharm.Origin{
+		Source:       apicommoncharm.OriginLocal,
+		Type:         origin.Type,
+		Revision:     &revision,
+		Base:         origin.Base,
+		Architecture: origin.Architecture,
+	}
+
+	return applicationAPIClient.SetCharm(model.GenerationMaster, apiapplication.SetCharmConfig{
+		ApplicationName: input.AppName,
+		CharmID: apiapplication.CharmID{
+			URL:    uploadedURL.String(),
+			Origin: newOrigin,
+		},
+		StorageConstraints: input.StorageConstraints,
+	})
+}
+
+// basesContainCharmBases returns true if the given base is one of the bases
+// declared by the charm. A charm that declares no bases is considered to
+// support any base.
+func basesContainCharmBases(base corebase.Base, ch charm.Charm) bool {
+	charmBases, err := corecharm.ComputedBases(ch)
+	if err != nil || len(charmBases) == 0 {
+		return true
+	}
+	return basesContain(base, charmBases)
+}
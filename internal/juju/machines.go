@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -83,6 +84,13 @@ type ReadMachineResponse struct {
 type DestroyMachineInput struct {
 	ModelUUID string
 	ID        string
+	// Force removes the machine ignoring errors, e.g. of a stuck
+	// agent. MaxWait is how long each step of the forced removal
+	// waits before moving on.
+	Force   bool
+	MaxWait *time.Duration
+	// Keep leaves the cloud instance of the machine running.
+	Keep bool
 }
 
 func newMachinesClient(sc SharedClient) *machinesClient {
@@ -368,6 +376,32 @@ func (c *machinesClient) ReadMachine(input *ReadMachineInput) (*ReadMachineRespo
 	}, nil
 }
 
+// ListMachines returns the IDs of the machines in the model, including
+// containers, sorted by ID.
+func (c *machinesClient) ListMachines(modelUUID string) ([]string, error) {
+	conn, err := c.GetConnection(&modelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.ModelStatus(modelUUID, conn)
+	if err != nil {
+		return nil, errors.Annotatef(err, "fetching status of model %q", modelUUID)
+	}
+	machineIDs := make([]string, 0, len(status.Machines))
+	var addMachines func(machines map[string]params.MachineStatus)
+	addMachines = func(machines map[string]params.MachineStatus) {
+		for id, machine := range machines {
+			machineIDs = append(machineIDs, id)
+			addMachines(machine.Containers)
+		}
+	}
+	addMachines(status.Machines)
+	sort.Strings(machineIDs)
+	return machineIDs, nil
+}
+
 func (c *machinesClient) DestroyMachine(input *DestroyMachineInput) error {
 	conn, err := c.GetConnection(&input.ModelUUID)
 	if err != nil {
@@ -377,10 +411,20 @@ func (c *machinesClient) DestroyMachine(input *DestroyMachineInput) error {
 
 	machineAPIClient := apimachinemanager.NewClient(conn)
 
-	_, err = machineAPIClient.DestroyMachinesWithParams(false, false, false, (*time.Duration)(nil), input.ID)
+	results, err := machineAPIClient.DestroyMachinesWithParams(input.Force, input.Keep, false, input.MaxWait, input.ID)
 	if err != nil {
 		return err
 	}
+	for _, result := range results {
+		if result.Error == nil {
+			continue
+		}
+		// A machine which is already gone, e.g. removed with its last
+		// unit, is destroyed.
+		if err := typedError(result.Error); !errors.Is(err, errors.NotFound) {
+			return err
+		}
+	}
 
 	return nil
 }
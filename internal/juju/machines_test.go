@@ -0,0 +1,92 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type MachineSuite struct {
+	suite.Suite
+	JujuSuite
+
+	testModelUUID string
+}
+
+func (s *MachineSuite) SetupSuite() {
+	s.testModelUUID = "fff19e46-8a0b-4a52-8e1d-7b16a40f8f2a"
+	s.testModelName = &s.testModelUUID
+}
+
+func (s *MachineSuite) TestListMachines() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockSharedClient.EXPECT().ModelStatus(s.testModelUUID, s.mockConnection).Return(&params.FullStatus{
+		Machines: map[string]params.MachineStatus{
+			"1": {},
+			"0": {
+				Containers: map[string]params.MachineStatus{
+					"0/lxd/0": {},
+				},
+			},
+		},
+	}, nil)
+	client := newMachinesClient(s.mockSharedClient)
+
+	machineIDs, err := client.ListMachines(s.testModelUUID)
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"0", "0/lxd/0", "1"}, machineIDs)
+}
+
+func (s *MachineSuite) expectDestroyMachine(result params.DestroyMachineResult) {
+	s.mockConnection.EXPECT().BestFacadeVersion("MachineManager").Return(10).AnyTimes()
+	s.mockConnection.EXPECT().APICall("MachineManager", 10, "", "DestroyMachineWithParams", params.DestroyMachinesParams{
+		MachineTags: []string{"machine-1"},
+	}, gomock.Any()).DoAndReturn(func(_ string, _ int, _, _ string, _, response any) error {
+		*(response.(*params.DestroyMachineResults)) = params.DestroyMachineResults{
+			Results: []params.DestroyMachineResult{result},
+		}
+		return nil
+	})
+}
+
+func (s *MachineSuite) TestDestroyMachine() {
+	defer s.setupMocks(s.T()).Finish()
+	s.expectDestroyMachine(params.DestroyMachineResult{})
+	client := newMachinesClient(s.mockSharedClient)
+
+	err := client.DestroyMachine(&DestroyMachineInput{ModelUUID: s.testModelUUID, ID: "1"})
+	s.Require().NoError(err)
+}
+
+func (s *MachineSuite) TestDestroyMachineNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+	s.expectDestroyMachine(params.DestroyMachineResult{
+		Error: &params.Error{Code: params.CodeNotFound, Message: "machine 1 not found"},
+	})
+	client := newMachinesClient(s.mockSharedClient)
+
+	err := client.DestroyMachine(&DestroyMachineInput{ModelUUID: s.testModelUUID, ID: "1"})
+	s.Require().NoError(err)
+}
+
+func (s *MachineSuite) TestDestroyMachineError() {
+	defer s.setupMocks(s.T()).Finish()
+	s.expectDestroyMachine(params.DestroyMachineResult{
+		Error: &params.Error{Message: "machine 1 has unit \"ubuntu/0\" assigned"},
+	})
+	client := newMachinesClient(s.mockSharedClient)
+
+	err := client.DestroyMachine(&DestroyMachineInput{ModelUUID: s.testModelUUID, ID: "1"})
+	s.Require().ErrorContains(err, `machine 1 has unit "ubuntu/0" assigned`)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestMachineSuite(t *testing.T) {
+	suite.Run(t, new(MachineSuite))
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/terraform-provider-juju/internal/juju (interfaces: SharedClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,ResourceAPIClient,SecretAPIClient,JaasAPIClient,KubernetesCloudAPIClient,CharmhubClient)
+// Source: github.com/juju/terraform-provider-juju/internal/juju (interfaces: SharedClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,LocalCharmAPIClient,MachineManagerAPIClient,ApplicationOffersAPIClient,AnnotationsAPIClient,ResourceAPIClient,SecretAPIClient,SecretBackendAPIClient,SpaceAPIClient,SubnetAPIClient,JaasAPIClient,KubernetesCloudAPIClient,CharmhubClient,ActionAPIClient)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package juju -destination mock_test.go github.com/juju/terraform-provider-juju/internal/juju SharedClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,ResourceAPIClient,SecretAPIClient,JaasAPIClient,KubernetesCloudAPIClient,CharmhubClient
+//	mockgen -typed -package juju -destination mock_test.go github.com/juju/terraform-provider-juju/internal/juju SharedClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,LocalCharmAPIClient,MachineManagerAPIClient,ApplicationOffersAPIClient,AnnotationsAPIClient,ResourceAPIClient,SecretAPIClient,SecretBackendAPIClient,SpaceAPIClient,SubnetAPIClient,JaasAPIClient,KubernetesCloudAPIClient,CharmhubClient,ActionAPIClient
 //
 
 // Package juju is a generated GoMock package.
@@ -18,20 +18,24 @@ import (
 	charm "github.com/juju/charm/v12"
 	resource "github.com/juju/charm/v12/resource"
 	api "github.com/juju/juju/api"
+	action "github.com/juju/juju/api/client/action"
 	application "github.com/juju/juju/api/client/application"
 	client "github.com/juju/juju/api/client/client"
 	resources "github.com/juju/juju/api/client/resources"
+	secretbackends "github.com/juju/juju/api/client/secretbackends"
 	secrets "github.com/juju/juju/api/client/secrets"
 	charm0 "github.com/juju/juju/api/common/charm"
 	charmhub "github.com/juju/juju/charmhub"
 	transport "github.com/juju/juju/charmhub/transport"
 	cloud "github.com/juju/juju/cloud"
 	constraints "github.com/juju/juju/core/constraints"
+	crossmodel "github.com/juju/juju/core/crossmodel"
 	model "github.com/juju/juju/core/model"
 	resources0 "github.com/juju/juju/core/resources"
 	secrets0 "github.com/juju/juju/core/secrets"
 	params0 "github.com/juju/juju/rpc/params"
 	names "github.com/juju/names/v5"
+	version "github.com/juju/version/v2"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -289,6 +293,45 @@ func (c *MockSharedClientModelOwnerAndNameCall) DoAndReturn(f func(string) (stri
 	return c
 }
 
+// ModelSnapshot mocks base method.
+func (m *MockSharedClient) ModelSnapshot(modelUUID string) (*ModelSnapshot, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelSnapshot", modelUUID)
+	ret0, _ := ret[0].(*ModelSnapshot)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// ModelSnapshot indicates an expected call of ModelSnapshot.
+func (mr *MockSharedClientMockRecorder) ModelSnapshot(modelUUID any) *MockSharedClientModelSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelSnapshot", reflect.TypeOf((*MockSharedClient)(nil).ModelSnapshot), modelUUID)
+	return &MockSharedClientModelSnapshotCall{Call: call}
+}
+
+// MockSharedClientModelSnapshotCall wrap *gomock.Call
+type MockSharedClientModelSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSharedClientModelSnapshotCall) Return(arg0 *ModelSnapshot, arg1 bool) *MockSharedClientModelSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSharedClientModelSnapshotCall) Do(f func(string) (*ModelSnapshot, bool)) *MockSharedClientModelSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSharedClientModelSnapshotCall) DoAndReturn(f func(string) (*ModelSnapshot, bool)) *MockSharedClientModelSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ModelStatus mocks base method.
 func (m *MockSharedClient) ModelStatus(modelUUID string, conn api.Connection) (*params0.FullStatus, error) {
 	m.ctrl.T.Helper()
@@ -649,6 +692,45 @@ func (m *MockApplicationAPIClient) EXPECT() *MockApplicationAPIClientMockRecorde
 	return m.recorder
 }
 
+// AddRelation mocks base method.
+func (m *MockApplicationAPIClient) AddRelation(endpoints, viaCIDRs []string) (*params0.AddRelationResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRelation", endpoints, viaCIDRs)
+	ret0, _ := ret[0].(*params0.AddRelationResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRelation indicates an expected call of AddRelation.
+func (mr *MockApplicationAPIClientMockRecorder) AddRelation(endpoints, viaCIDRs any) *MockApplicationAPIClientAddRelationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRelation", reflect.TypeOf((*MockApplicationAPIClient)(nil).AddRelation), endpoints, viaCIDRs)
+	return &MockApplicationAPIClientAddRelationCall{Call: call}
+}
+
+// MockApplicationAPIClientAddRelationCall wrap *gomock.Call
+type MockApplicationAPIClientAddRelationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationAPIClientAddRelationCall) Return(arg0 *params0.AddRelationResults, arg1 error) *MockApplicationAPIClientAddRelationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationAPIClientAddRelationCall) Do(f func([]string, []string) (*params0.AddRelationResults, error)) *MockApplicationAPIClientAddRelationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationAPIClientAddRelationCall) DoAndReturn(f func([]string, []string) (*params0.AddRelationResults, error)) *MockApplicationAPIClientAddRelationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddUnits mocks base method.
 func (m *MockApplicationAPIClient) AddUnits(args application.AddUnitsParams) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1000,6 +1082,50 @@ func (c *MockApplicationAPIClientGetCharmURLOriginCall) DoAndReturn(f func(strin
 	return c
 }
 
+// GetConfig mocks base method.
+func (m *MockApplicationAPIClient) GetConfig(branchName string, appNames ...string) ([]map[string]any, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{branchName}
+	for _, a := range appNames {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConfig", varargs...)
+	ret0, _ := ret[0].([]map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockApplicationAPIClientMockRecorder) GetConfig(branchName any, appNames ...any) *MockApplicationAPIClientGetConfigCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{branchName}, appNames...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockApplicationAPIClient)(nil).GetConfig), varargs...)
+	return &MockApplicationAPIClientGetConfigCall{Call: call}
+}
+
+// MockApplicationAPIClientGetConfigCall wrap *gomock.Call
+type MockApplicationAPIClientGetConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationAPIClientGetConfigCall) Return(arg0 []map[string]any, arg1 error) *MockApplicationAPIClientGetConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationAPIClientGetConfigCall) Do(f func(string, ...string) ([]map[string]any, error)) *MockApplicationAPIClientGetConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationAPIClientGetConfigCall) DoAndReturn(f func(string, ...string) ([]map[string]any, error)) *MockApplicationAPIClientGetConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetConstraints mocks base method.
 func (m *MockApplicationAPIClient) GetConstraints(applications ...string) ([]constraints.Value, error) {
 	m.ctrl.T.Helper()
@@ -1334,476 +1460,1466 @@ func (m *MockModelConfigAPIClient) EXPECT() *MockModelConfigAPIClientMockRecorde
 	return m.recorder
 }
 
-// ModelGet mocks base method.
-func (m *MockModelConfigAPIClient) ModelGet() (map[string]any, error) {
+// GetModelConstraints mocks base method.
+func (m *MockModelConfigAPIClient) GetModelConstraints() (constraints.Value, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModelGet")
-	ret0, _ := ret[0].(map[string]any)
+	ret := m.ctrl.Call(m, "GetModelConstraints")
+	ret0, _ := ret[0].(constraints.Value)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ModelGet indicates an expected call of ModelGet.
-func (mr *MockModelConfigAPIClientMockRecorder) ModelGet() *MockModelConfigAPIClientModelGetCall {
+// GetModelConstraints indicates an expected call of GetModelConstraints.
+func (mr *MockModelConfigAPIClientMockRecorder) GetModelConstraints() *MockModelConfigAPIClientGetModelConstraintsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelGet", reflect.TypeOf((*MockModelConfigAPIClient)(nil).ModelGet))
-	return &MockModelConfigAPIClientModelGetCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelConstraints", reflect.TypeOf((*MockModelConfigAPIClient)(nil).GetModelConstraints))
+	return &MockModelConfigAPIClientGetModelConstraintsCall{Call: call}
 }
 
-// MockModelConfigAPIClientModelGetCall wrap *gomock.Call
-type MockModelConfigAPIClientModelGetCall struct {
+// MockModelConfigAPIClientGetModelConstraintsCall wrap *gomock.Call
+type MockModelConfigAPIClientGetModelConstraintsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockModelConfigAPIClientModelGetCall) Return(arg0 map[string]any, arg1 error) *MockModelConfigAPIClientModelGetCall {
+func (c *MockModelConfigAPIClientGetModelConstraintsCall) Return(arg0 constraints.Value, arg1 error) *MockModelConfigAPIClientGetModelConstraintsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockModelConfigAPIClientModelGetCall) Do(f func() (map[string]any, error)) *MockModelConfigAPIClientModelGetCall {
+func (c *MockModelConfigAPIClientGetModelConstraintsCall) Do(f func() (constraints.Value, error)) *MockModelConfigAPIClientGetModelConstraintsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockModelConfigAPIClientModelGetCall) DoAndReturn(f func() (map[string]any, error)) *MockModelConfigAPIClientModelGetCall {
+func (c *MockModelConfigAPIClientGetModelConstraintsCall) DoAndReturn(f func() (constraints.Value, error)) *MockModelConfigAPIClientGetModelConstraintsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockResourceAPIClient is a mock of ResourceAPIClient interface.
-type MockResourceAPIClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockResourceAPIClientMockRecorder
-	isgomock struct{}
-}
-
-// MockResourceAPIClientMockRecorder is the mock recorder for MockResourceAPIClient.
-type MockResourceAPIClientMockRecorder struct {
-	mock *MockResourceAPIClient
-}
-
-// NewMockResourceAPIClient creates a new mock instance.
-func NewMockResourceAPIClient(ctrl *gomock.Controller) *MockResourceAPIClient {
-	mock := &MockResourceAPIClient{ctrl: ctrl}
-	mock.recorder = &MockResourceAPIClientMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockResourceAPIClient) EXPECT() *MockResourceAPIClientMockRecorder {
-	return m.recorder
-}
-
-// AddPendingResources mocks base method.
-func (m *MockResourceAPIClient) AddPendingResources(args resources.AddPendingResourcesArgs) ([]string, error) {
+// ModelGet mocks base method.
+func (m *MockModelConfigAPIClient) ModelGet() (map[string]any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddPendingResources", args)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "ModelGet")
+	ret0, _ := ret[0].(map[string]any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddPendingResources indicates an expected call of AddPendingResources.
-func (mr *MockResourceAPIClientMockRecorder) AddPendingResources(args any) *MockResourceAPIClientAddPendingResourcesCall {
+// ModelGet indicates an expected call of ModelGet.
+func (mr *MockModelConfigAPIClientMockRecorder) ModelGet() *MockModelConfigAPIClientModelGetCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPendingResources", reflect.TypeOf((*MockResourceAPIClient)(nil).AddPendingResources), args)
-	return &MockResourceAPIClientAddPendingResourcesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelGet", reflect.TypeOf((*MockModelConfigAPIClient)(nil).ModelGet))
+	return &MockModelConfigAPIClientModelGetCall{Call: call}
 }
 
-// MockResourceAPIClientAddPendingResourcesCall wrap *gomock.Call
-type MockResourceAPIClientAddPendingResourcesCall struct {
+// MockModelConfigAPIClientModelGetCall wrap *gomock.Call
+type MockModelConfigAPIClientModelGetCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockResourceAPIClientAddPendingResourcesCall) Return(arg0 []string, arg1 error) *MockResourceAPIClientAddPendingResourcesCall {
+func (c *MockModelConfigAPIClientModelGetCall) Return(arg0 map[string]any, arg1 error) *MockModelConfigAPIClientModelGetCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockResourceAPIClientAddPendingResourcesCall) Do(f func(resources.AddPendingResourcesArgs) ([]string, error)) *MockResourceAPIClientAddPendingResourcesCall {
+func (c *MockModelConfigAPIClientModelGetCall) Do(f func() (map[string]any, error)) *MockModelConfigAPIClientModelGetCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockResourceAPIClientAddPendingResourcesCall) DoAndReturn(f func(resources.AddPendingResourcesArgs) ([]string, error)) *MockResourceAPIClientAddPendingResourcesCall {
+func (c *MockModelConfigAPIClientModelGetCall) DoAndReturn(f func() (map[string]any, error)) *MockModelConfigAPIClientModelGetCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListResources mocks base method.
-func (m *MockResourceAPIClient) ListResources(applications []string) ([]resources0.ApplicationResources, error) {
+// Sequences mocks base method.
+func (m *MockModelConfigAPIClient) Sequences() (map[string]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListResources", applications)
-	ret0, _ := ret[0].([]resources0.ApplicationResources)
+	ret := m.ctrl.Call(m, "Sequences")
+	ret0, _ := ret[0].(map[string]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListResources indicates an expected call of ListResources.
-func (mr *MockResourceAPIClientMockRecorder) ListResources(applications any) *MockResourceAPIClientListResourcesCall {
+// Sequences indicates an expected call of Sequences.
+func (mr *MockModelConfigAPIClientMockRecorder) Sequences() *MockModelConfigAPIClientSequencesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockResourceAPIClient)(nil).ListResources), applications)
-	return &MockResourceAPIClientListResourcesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sequences", reflect.TypeOf((*MockModelConfigAPIClient)(nil).Sequences))
+	return &MockModelConfigAPIClientSequencesCall{Call: call}
 }
 
-// MockResourceAPIClientListResourcesCall wrap *gomock.Call
-type MockResourceAPIClientListResourcesCall struct {
+// MockModelConfigAPIClientSequencesCall wrap *gomock.Call
+type MockModelConfigAPIClientSequencesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockResourceAPIClientListResourcesCall) Return(arg0 []resources0.ApplicationResources, arg1 error) *MockResourceAPIClientListResourcesCall {
+func (c *MockModelConfigAPIClientSequencesCall) Return(arg0 map[string]int, arg1 error) *MockModelConfigAPIClientSequencesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockResourceAPIClientListResourcesCall) Do(f func([]string) ([]resources0.ApplicationResources, error)) *MockResourceAPIClientListResourcesCall {
+func (c *MockModelConfigAPIClientSequencesCall) Do(f func() (map[string]int, error)) *MockModelConfigAPIClientSequencesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockResourceAPIClientListResourcesCall) DoAndReturn(f func([]string) ([]resources0.ApplicationResources, error)) *MockResourceAPIClientListResourcesCall {
+func (c *MockModelConfigAPIClientSequencesCall) DoAndReturn(f func() (map[string]int, error)) *MockModelConfigAPIClientSequencesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Upload mocks base method.
-func (m *MockResourceAPIClient) Upload(application, name, filename, pendingID string, reader io.ReadSeeker) error {
+// MockLocalCharmAPIClient is a mock of LocalCharmAPIClient interface.
+type MockLocalCharmAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocalCharmAPIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockLocalCharmAPIClientMockRecorder is the mock recorder for MockLocalCharmAPIClient.
+type MockLocalCharmAPIClientMockRecorder struct {
+	mock *MockLocalCharmAPIClient
+}
+
+// NewMockLocalCharmAPIClient creates a new mock instance.
+func NewMockLocalCharmAPIClient(ctrl *gomock.Controller) *MockLocalCharmAPIClient {
+	mock := &MockLocalCharmAPIClient{ctrl: ctrl}
+	mock.recorder = &MockLocalCharmAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocalCharmAPIClient) EXPECT() *MockLocalCharmAPIClientMockRecorder {
+	return m.recorder
+}
+
+// AddLocalCharm mocks base method.
+func (m *MockLocalCharmAPIClient) AddLocalCharm(curl *charm.URL, ch charm.Charm, force bool, agentVersion version.Number) (*charm.URL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upload", application, name, filename, pendingID, reader)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AddLocalCharm", curl, ch, force, agentVersion)
+	ret0, _ := ret[0].(*charm.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Upload indicates an expected call of Upload.
-func (mr *MockResourceAPIClientMockRecorder) Upload(application, name, filename, pendingID, reader any) *MockResourceAPIClientUploadCall {
+// AddLocalCharm indicates an expected call of AddLocalCharm.
+func (mr *MockLocalCharmAPIClientMockRecorder) AddLocalCharm(curl, ch, force, agentVersion any) *MockLocalCharmAPIClientAddLocalCharmCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockResourceAPIClient)(nil).Upload), application, name, filename, pendingID, reader)
-	return &MockResourceAPIClientUploadCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLocalCharm", reflect.TypeOf((*MockLocalCharmAPIClient)(nil).AddLocalCharm), curl, ch, force, agentVersion)
+	return &MockLocalCharmAPIClientAddLocalCharmCall{Call: call}
 }
 
-// MockResourceAPIClientUploadCall wrap *gomock.Call
-type MockResourceAPIClientUploadCall struct {
+// MockLocalCharmAPIClientAddLocalCharmCall wrap *gomock.Call
+type MockLocalCharmAPIClientAddLocalCharmCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockResourceAPIClientUploadCall) Return(arg0 error) *MockResourceAPIClientUploadCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockLocalCharmAPIClientAddLocalCharmCall) Return(arg0 *charm.URL, arg1 error) *MockLocalCharmAPIClientAddLocalCharmCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockResourceAPIClientUploadCall) Do(f func(string, string, string, string, io.ReadSeeker) error) *MockResourceAPIClientUploadCall {
+func (c *MockLocalCharmAPIClientAddLocalCharmCall) Do(f func(*charm.URL, charm.Charm, bool, version.Number) (*charm.URL, error)) *MockLocalCharmAPIClientAddLocalCharmCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockResourceAPIClientUploadCall) DoAndReturn(f func(string, string, string, string, io.ReadSeeker) error) *MockResourceAPIClientUploadCall {
+func (c *MockLocalCharmAPIClientAddLocalCharmCall) DoAndReturn(f func(*charm.URL, charm.Charm, bool, version.Number) (*charm.URL, error)) *MockLocalCharmAPIClientAddLocalCharmCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UploadPendingResource mocks base method.
-func (m *MockResourceAPIClient) UploadPendingResource(applicationID string, resource resource.Resource, filename string, r io.ReadSeeker) (string, error) {
+// MockMachineManagerAPIClient is a mock of MachineManagerAPIClient interface.
+type MockMachineManagerAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMachineManagerAPIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockMachineManagerAPIClientMockRecorder is the mock recorder for MockMachineManagerAPIClient.
+type MockMachineManagerAPIClientMockRecorder struct {
+	mock *MockMachineManagerAPIClient
+}
+
+// NewMockMachineManagerAPIClient creates a new mock instance.
+func NewMockMachineManagerAPIClient(ctrl *gomock.Controller) *MockMachineManagerAPIClient {
+	mock := &MockMachineManagerAPIClient{ctrl: ctrl}
+	mock.recorder = &MockMachineManagerAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMachineManagerAPIClient) EXPECT() *MockMachineManagerAPIClientMockRecorder {
+	return m.recorder
+}
+
+// AddMachines mocks base method.
+func (m *MockMachineManagerAPIClient) AddMachines(machineParams []params0.AddMachineParams) ([]params0.AddMachinesResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadPendingResource", applicationID, resource, filename, r)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "AddMachines", machineParams)
+	ret0, _ := ret[0].([]params0.AddMachinesResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UploadPendingResource indicates an expected call of UploadPendingResource.
-func (mr *MockResourceAPIClientMockRecorder) UploadPendingResource(applicationID, resource, filename, r any) *MockResourceAPIClientUploadPendingResourceCall {
+// AddMachines indicates an expected call of AddMachines.
+func (mr *MockMachineManagerAPIClientMockRecorder) AddMachines(machineParams any) *MockMachineManagerAPIClientAddMachinesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPendingResource", reflect.TypeOf((*MockResourceAPIClient)(nil).UploadPendingResource), applicationID, resource, filename, r)
-	return &MockResourceAPIClientUploadPendingResourceCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMachines", reflect.TypeOf((*MockMachineManagerAPIClient)(nil).AddMachines), machineParams)
+	return &MockMachineManagerAPIClientAddMachinesCall{Call: call}
 }
 
-// MockResourceAPIClientUploadPendingResourceCall wrap *gomock.Call
-type MockResourceAPIClientUploadPendingResourceCall struct {
+// MockMachineManagerAPIClientAddMachinesCall wrap *gomock.Call
+type MockMachineManagerAPIClientAddMachinesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockResourceAPIClientUploadPendingResourceCall) Return(id string, err error) *MockResourceAPIClientUploadPendingResourceCall {
-	c.Call = c.Call.Return(id, err)
+func (c *MockMachineManagerAPIClientAddMachinesCall) Return(arg0 []params0.AddMachinesResult, arg1 error) *MockMachineManagerAPIClientAddMachinesCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockResourceAPIClientUploadPendingResourceCall) Do(f func(string, resource.Resource, string, io.ReadSeeker) (string, error)) *MockResourceAPIClientUploadPendingResourceCall {
+func (c *MockMachineManagerAPIClientAddMachinesCall) Do(f func([]params0.AddMachineParams) ([]params0.AddMachinesResult, error)) *MockMachineManagerAPIClientAddMachinesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockResourceAPIClientUploadPendingResourceCall) DoAndReturn(f func(string, resource.Resource, string, io.ReadSeeker) (string, error)) *MockResourceAPIClientUploadPendingResourceCall {
+func (c *MockMachineManagerAPIClientAddMachinesCall) DoAndReturn(f func([]params0.AddMachineParams) ([]params0.AddMachinesResult, error)) *MockMachineManagerAPIClientAddMachinesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockSecretAPIClient is a mock of SecretAPIClient interface.
-type MockSecretAPIClient struct {
+// MockApplicationOffersAPIClient is a mock of ApplicationOffersAPIClient interface.
+type MockApplicationOffersAPIClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockSecretAPIClientMockRecorder
+	recorder *MockApplicationOffersAPIClientMockRecorder
 	isgomock struct{}
 }
 
-// MockSecretAPIClientMockRecorder is the mock recorder for MockSecretAPIClient.
-type MockSecretAPIClientMockRecorder struct {
-	mock *MockSecretAPIClient
+// MockApplicationOffersAPIClientMockRecorder is the mock recorder for MockApplicationOffersAPIClient.
+type MockApplicationOffersAPIClientMockRecorder struct {
+	mock *MockApplicationOffersAPIClient
 }
 
-// NewMockSecretAPIClient creates a new mock instance.
-func NewMockSecretAPIClient(ctrl *gomock.Controller) *MockSecretAPIClient {
-	mock := &MockSecretAPIClient{ctrl: ctrl}
-	mock.recorder = &MockSecretAPIClientMockRecorder{mock}
+// NewMockApplicationOffersAPIClient creates a new mock instance.
+func NewMockApplicationOffersAPIClient(ctrl *gomock.Controller) *MockApplicationOffersAPIClient {
+	mock := &MockApplicationOffersAPIClient{ctrl: ctrl}
+	mock.recorder = &MockApplicationOffersAPIClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSecretAPIClient) EXPECT() *MockSecretAPIClientMockRecorder {
+func (m *MockApplicationOffersAPIClient) EXPECT() *MockApplicationOffersAPIClientMockRecorder {
 	return m.recorder
 }
 
-// CreateSecret mocks base method.
-func (m *MockSecretAPIClient) CreateSecret(name, description string, data map[string]string) (string, error) {
+// ApplicationOffer mocks base method.
+func (m *MockApplicationOffersAPIClient) ApplicationOffer(urlStr string) (*crossmodel.ApplicationOfferDetails, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecret", name, description, data)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ApplicationOffer", urlStr)
+	ret0, _ := ret[0].(*crossmodel.ApplicationOfferDetails)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSecret indicates an expected call of CreateSecret.
-func (mr *MockSecretAPIClientMockRecorder) CreateSecret(name, description, data any) *MockSecretAPIClientCreateSecretCall {
+// ApplicationOffer indicates an expected call of ApplicationOffer.
+func (mr *MockApplicationOffersAPIClientMockRecorder) ApplicationOffer(urlStr any) *MockApplicationOffersAPIClientApplicationOfferCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).CreateSecret), name, description, data)
-	return &MockSecretAPIClientCreateSecretCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationOffer", reflect.TypeOf((*MockApplicationOffersAPIClient)(nil).ApplicationOffer), urlStr)
+	return &MockApplicationOffersAPIClientApplicationOfferCall{Call: call}
 }
 
-// MockSecretAPIClientCreateSecretCall wrap *gomock.Call
-type MockSecretAPIClientCreateSecretCall struct {
+// MockApplicationOffersAPIClientApplicationOfferCall wrap *gomock.Call
+type MockApplicationOffersAPIClientApplicationOfferCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretAPIClientCreateSecretCall) Return(arg0 string, arg1 error) *MockSecretAPIClientCreateSecretCall {
+func (c *MockApplicationOffersAPIClientApplicationOfferCall) Return(arg0 *crossmodel.ApplicationOfferDetails, arg1 error) *MockApplicationOffersAPIClientApplicationOfferCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretAPIClientCreateSecretCall) Do(f func(string, string, map[string]string) (string, error)) *MockSecretAPIClientCreateSecretCall {
+func (c *MockApplicationOffersAPIClientApplicationOfferCall) Do(f func(string) (*crossmodel.ApplicationOfferDetails, error)) *MockApplicationOffersAPIClientApplicationOfferCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretAPIClientCreateSecretCall) DoAndReturn(f func(string, string, map[string]string) (string, error)) *MockSecretAPIClientCreateSecretCall {
+func (c *MockApplicationOffersAPIClientApplicationOfferCall) DoAndReturn(f func(string) (*crossmodel.ApplicationOfferDetails, error)) *MockApplicationOffersAPIClientApplicationOfferCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GrantSecret mocks base method.
-func (m *MockSecretAPIClient) GrantSecret(uri *secrets0.URI, name string, apps []string) ([]error, error) {
+// DestroyOffers mocks base method.
+func (m *MockApplicationOffersAPIClient) DestroyOffers(force bool, offerURLs ...string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GrantSecret", uri, name, apps)
-	ret0, _ := ret[0].([]error)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{force}
+	for _, a := range offerURLs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DestroyOffers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GrantSecret indicates an expected call of GrantSecret.
-func (mr *MockSecretAPIClientMockRecorder) GrantSecret(uri, name, apps any) *MockSecretAPIClientGrantSecretCall {
+// DestroyOffers indicates an expected call of DestroyOffers.
+func (mr *MockApplicationOffersAPIClientMockRecorder) DestroyOffers(force any, offerURLs ...any) *MockApplicationOffersAPIClientDestroyOffersCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).GrantSecret), uri, name, apps)
-	return &MockSecretAPIClientGrantSecretCall{Call: call}
+	varargs := append([]any{force}, offerURLs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyOffers", reflect.TypeOf((*MockApplicationOffersAPIClient)(nil).DestroyOffers), varargs...)
+	return &MockApplicationOffersAPIClientDestroyOffersCall{Call: call}
 }
 
-// MockSecretAPIClientGrantSecretCall wrap *gomock.Call
+// MockApplicationOffersAPIClientDestroyOffersCall wrap *gomock.Call
+type MockApplicationOffersAPIClientDestroyOffersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationOffersAPIClientDestroyOffersCall) Return(arg0 error) *MockApplicationOffersAPIClientDestroyOffersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationOffersAPIClientDestroyOffersCall) Do(f func(bool, ...string) error) *MockApplicationOffersAPIClientDestroyOffersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationOffersAPIClientDestroyOffersCall) DoAndReturn(f func(bool, ...string) error) *MockApplicationOffersAPIClientDestroyOffersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GrantOffer mocks base method.
+func (m *MockApplicationOffersAPIClient) GrantOffer(user, access string, offerURLs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{user, access}
+	for _, a := range offerURLs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GrantOffer", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantOffer indicates an expected call of GrantOffer.
+func (mr *MockApplicationOffersAPIClientMockRecorder) GrantOffer(user, access any, offerURLs ...any) *MockApplicationOffersAPIClientGrantOfferCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{user, access}, offerURLs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantOffer", reflect.TypeOf((*MockApplicationOffersAPIClient)(nil).GrantOffer), varargs...)
+	return &MockApplicationOffersAPIClientGrantOfferCall{Call: call}
+}
+
+// MockApplicationOffersAPIClientGrantOfferCall wrap *gomock.Call
+type MockApplicationOffersAPIClientGrantOfferCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationOffersAPIClientGrantOfferCall) Return(arg0 error) *MockApplicationOffersAPIClientGrantOfferCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationOffersAPIClientGrantOfferCall) Do(f func(string, string, ...string) error) *MockApplicationOffersAPIClientGrantOfferCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationOffersAPIClientGrantOfferCall) DoAndReturn(f func(string, string, ...string) error) *MockApplicationOffersAPIClientGrantOfferCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Offer mocks base method.
+func (m *MockApplicationOffersAPIClient) Offer(modelUUID, application string, endpoints []string, owner, offerName, desc string) ([]params0.ErrorResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Offer", modelUUID, application, endpoints, owner, offerName, desc)
+	ret0, _ := ret[0].([]params0.ErrorResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Offer indicates an expected call of Offer.
+func (mr *MockApplicationOffersAPIClientMockRecorder) Offer(modelUUID, application, endpoints, owner, offerName, desc any) *MockApplicationOffersAPIClientOfferCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Offer", reflect.TypeOf((*MockApplicationOffersAPIClient)(nil).Offer), modelUUID, application, endpoints, owner, offerName, desc)
+	return &MockApplicationOffersAPIClientOfferCall{Call: call}
+}
+
+// MockApplicationOffersAPIClientOfferCall wrap *gomock.Call
+type MockApplicationOffersAPIClientOfferCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationOffersAPIClientOfferCall) Return(arg0 []params0.ErrorResult, arg1 error) *MockApplicationOffersAPIClientOfferCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationOffersAPIClientOfferCall) Do(f func(string, string, []string, string, string, string) ([]params0.ErrorResult, error)) *MockApplicationOffersAPIClientOfferCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationOffersAPIClientOfferCall) DoAndReturn(f func(string, string, []string, string, string, string) ([]params0.ErrorResult, error)) *MockApplicationOffersAPIClientOfferCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockAnnotationsAPIClient is a mock of AnnotationsAPIClient interface.
+type MockAnnotationsAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnnotationsAPIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAnnotationsAPIClientMockRecorder is the mock recorder for MockAnnotationsAPIClient.
+type MockAnnotationsAPIClientMockRecorder struct {
+	mock *MockAnnotationsAPIClient
+}
+
+// NewMockAnnotationsAPIClient creates a new mock instance.
+func NewMockAnnotationsAPIClient(ctrl *gomock.Controller) *MockAnnotationsAPIClient {
+	mock := &MockAnnotationsAPIClient{ctrl: ctrl}
+	mock.recorder = &MockAnnotationsAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnnotationsAPIClient) EXPECT() *MockAnnotationsAPIClientMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAnnotationsAPIClient) Get(tags []string) ([]params0.AnnotationsGetResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", tags)
+	ret0, _ := ret[0].([]params0.AnnotationsGetResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAnnotationsAPIClientMockRecorder) Get(tags any) *MockAnnotationsAPIClientGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAnnotationsAPIClient)(nil).Get), tags)
+	return &MockAnnotationsAPIClientGetCall{Call: call}
+}
+
+// MockAnnotationsAPIClientGetCall wrap *gomock.Call
+type MockAnnotationsAPIClientGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAnnotationsAPIClientGetCall) Return(arg0 []params0.AnnotationsGetResult, arg1 error) *MockAnnotationsAPIClientGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAnnotationsAPIClientGetCall) Do(f func([]string) ([]params0.AnnotationsGetResult, error)) *MockAnnotationsAPIClientGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAnnotationsAPIClientGetCall) DoAndReturn(f func([]string) ([]params0.AnnotationsGetResult, error)) *MockAnnotationsAPIClientGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Set mocks base method.
+func (m *MockAnnotationsAPIClient) Set(annotations map[string]map[string]string) ([]params0.ErrorResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", annotations)
+	ret0, _ := ret[0].([]params0.ErrorResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockAnnotationsAPIClientMockRecorder) Set(annotations any) *MockAnnotationsAPIClientSetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockAnnotationsAPIClient)(nil).Set), annotations)
+	return &MockAnnotationsAPIClientSetCall{Call: call}
+}
+
+// MockAnnotationsAPIClientSetCall wrap *gomock.Call
+type MockAnnotationsAPIClientSetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAnnotationsAPIClientSetCall) Return(arg0 []params0.ErrorResult, arg1 error) *MockAnnotationsAPIClientSetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAnnotationsAPIClientSetCall) Do(f func(map[string]map[string]string) ([]params0.ErrorResult, error)) *MockAnnotationsAPIClientSetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAnnotationsAPIClientSetCall) DoAndReturn(f func(map[string]map[string]string) ([]params0.ErrorResult, error)) *MockAnnotationsAPIClientSetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockResourceAPIClient is a mock of ResourceAPIClient interface.
+type MockResourceAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceAPIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceAPIClientMockRecorder is the mock recorder for MockResourceAPIClient.
+type MockResourceAPIClientMockRecorder struct {
+	mock *MockResourceAPIClient
+}
+
+// NewMockResourceAPIClient creates a new mock instance.
+func NewMockResourceAPIClient(ctrl *gomock.Controller) *MockResourceAPIClient {
+	mock := &MockResourceAPIClient{ctrl: ctrl}
+	mock.recorder = &MockResourceAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceAPIClient) EXPECT() *MockResourceAPIClientMockRecorder {
+	return m.recorder
+}
+
+// AddPendingResources mocks base method.
+func (m *MockResourceAPIClient) AddPendingResources(args resources.AddPendingResourcesArgs) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPendingResources", args)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPendingResources indicates an expected call of AddPendingResources.
+func (mr *MockResourceAPIClientMockRecorder) AddPendingResources(args any) *MockResourceAPIClientAddPendingResourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPendingResources", reflect.TypeOf((*MockResourceAPIClient)(nil).AddPendingResources), args)
+	return &MockResourceAPIClientAddPendingResourcesCall{Call: call}
+}
+
+// MockResourceAPIClientAddPendingResourcesCall wrap *gomock.Call
+type MockResourceAPIClientAddPendingResourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceAPIClientAddPendingResourcesCall) Return(arg0 []string, arg1 error) *MockResourceAPIClientAddPendingResourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceAPIClientAddPendingResourcesCall) Do(f func(resources.AddPendingResourcesArgs) ([]string, error)) *MockResourceAPIClientAddPendingResourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceAPIClientAddPendingResourcesCall) DoAndReturn(f func(resources.AddPendingResourcesArgs) ([]string, error)) *MockResourceAPIClientAddPendingResourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListResources mocks base method.
+func (m *MockResourceAPIClient) ListResources(applications []string) ([]resources0.ApplicationResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", applications)
+	ret0, _ := ret[0].([]resources0.ApplicationResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockResourceAPIClientMockRecorder) ListResources(applications any) *MockResourceAPIClientListResourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockResourceAPIClient)(nil).ListResources), applications)
+	return &MockResourceAPIClientListResourcesCall{Call: call}
+}
+
+// MockResourceAPIClientListResourcesCall wrap *gomock.Call
+type MockResourceAPIClientListResourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceAPIClientListResourcesCall) Return(arg0 []resources0.ApplicationResources, arg1 error) *MockResourceAPIClientListResourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceAPIClientListResourcesCall) Do(f func([]string) ([]resources0.ApplicationResources, error)) *MockResourceAPIClientListResourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceAPIClientListResourcesCall) DoAndReturn(f func([]string) ([]resources0.ApplicationResources, error)) *MockResourceAPIClientListResourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Upload mocks base method.
+func (m *MockResourceAPIClient) Upload(application, name, filename, pendingID string, reader io.ReadSeeker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", application, name, filename, pendingID, reader)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockResourceAPIClientMockRecorder) Upload(application, name, filename, pendingID, reader any) *MockResourceAPIClientUploadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockResourceAPIClient)(nil).Upload), application, name, filename, pendingID, reader)
+	return &MockResourceAPIClientUploadCall{Call: call}
+}
+
+// MockResourceAPIClientUploadCall wrap *gomock.Call
+type MockResourceAPIClientUploadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceAPIClientUploadCall) Return(arg0 error) *MockResourceAPIClientUploadCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceAPIClientUploadCall) Do(f func(string, string, string, string, io.ReadSeeker) error) *MockResourceAPIClientUploadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceAPIClientUploadCall) DoAndReturn(f func(string, string, string, string, io.ReadSeeker) error) *MockResourceAPIClientUploadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UploadPendingResource mocks base method.
+func (m *MockResourceAPIClient) UploadPendingResource(applicationID string, resource resource.Resource, filename string, r io.ReadSeeker) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPendingResource", applicationID, resource, filename, r)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadPendingResource indicates an expected call of UploadPendingResource.
+func (mr *MockResourceAPIClientMockRecorder) UploadPendingResource(applicationID, resource, filename, r any) *MockResourceAPIClientUploadPendingResourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPendingResource", reflect.TypeOf((*MockResourceAPIClient)(nil).UploadPendingResource), applicationID, resource, filename, r)
+	return &MockResourceAPIClientUploadPendingResourceCall{Call: call}
+}
+
+// MockResourceAPIClientUploadPendingResourceCall wrap *gomock.Call
+type MockResourceAPIClientUploadPendingResourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceAPIClientUploadPendingResourceCall) Return(id string, err error) *MockResourceAPIClientUploadPendingResourceCall {
+	c.Call = c.Call.Return(id, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceAPIClientUploadPendingResourceCall) Do(f func(string, resource.Resource, string, io.ReadSeeker) (string, error)) *MockResourceAPIClientUploadPendingResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceAPIClientUploadPendingResourceCall) DoAndReturn(f func(string, resource.Resource, string, io.ReadSeeker) (string, error)) *MockResourceAPIClientUploadPendingResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSecretAPIClient is a mock of SecretAPIClient interface.
+type MockSecretAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretAPIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretAPIClientMockRecorder is the mock recorder for MockSecretAPIClient.
+type MockSecretAPIClientMockRecorder struct {
+	mock *MockSecretAPIClient
+}
+
+// NewMockSecretAPIClient creates a new mock instance.
+func NewMockSecretAPIClient(ctrl *gomock.Controller) *MockSecretAPIClient {
+	mock := &MockSecretAPIClient{ctrl: ctrl}
+	mock.recorder = &MockSecretAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretAPIClient) EXPECT() *MockSecretAPIClientMockRecorder {
+	return m.recorder
+}
+
+// CreateSecret mocks base method.
+func (m *MockSecretAPIClient) CreateSecret(name, description string, data map[string]string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecret", name, description, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockSecretAPIClientMockRecorder) CreateSecret(name, description, data any) *MockSecretAPIClientCreateSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).CreateSecret), name, description, data)
+	return &MockSecretAPIClientCreateSecretCall{Call: call}
+}
+
+// MockSecretAPIClientCreateSecretCall wrap *gomock.Call
+type MockSecretAPIClientCreateSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAPIClientCreateSecretCall) Return(arg0 string, arg1 error) *MockSecretAPIClientCreateSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAPIClientCreateSecretCall) Do(f func(string, string, map[string]string) (string, error)) *MockSecretAPIClientCreateSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAPIClientCreateSecretCall) DoAndReturn(f func(string, string, map[string]string) (string, error)) *MockSecretAPIClientCreateSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GrantSecret mocks base method.
+func (m *MockSecretAPIClient) GrantSecret(uri *secrets0.URI, name string, apps []string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantSecret", uri, name, apps)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantSecret indicates an expected call of GrantSecret.
+func (mr *MockSecretAPIClientMockRecorder) GrantSecret(uri, name, apps any) *MockSecretAPIClientGrantSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).GrantSecret), uri, name, apps)
+	return &MockSecretAPIClientGrantSecretCall{Call: call}
+}
+
+// MockSecretAPIClientGrantSecretCall wrap *gomock.Call
 type MockSecretAPIClientGrantSecretCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretAPIClientGrantSecretCall) Return(arg0 []error, arg1 error) *MockSecretAPIClientGrantSecretCall {
+func (c *MockSecretAPIClientGrantSecretCall) Return(arg0 []error, arg1 error) *MockSecretAPIClientGrantSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAPIClientGrantSecretCall) Do(f func(*secrets0.URI, string, []string) ([]error, error)) *MockSecretAPIClientGrantSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAPIClientGrantSecretCall) DoAndReturn(f func(*secrets0.URI, string, []string) ([]error, error)) *MockSecretAPIClientGrantSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSecrets mocks base method.
+func (m *MockSecretAPIClient) ListSecrets(reveal bool, filter secrets0.Filter) ([]secrets.SecretDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", reveal, filter)
+	ret0, _ := ret[0].([]secrets.SecretDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockSecretAPIClientMockRecorder) ListSecrets(reveal, filter any) *MockSecretAPIClientListSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockSecretAPIClient)(nil).ListSecrets), reveal, filter)
+	return &MockSecretAPIClientListSecretsCall{Call: call}
+}
+
+// MockSecretAPIClientListSecretsCall wrap *gomock.Call
+type MockSecretAPIClientListSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAPIClientListSecretsCall) Return(arg0 []secrets.SecretDetails, arg1 error) *MockSecretAPIClientListSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAPIClientListSecretsCall) Do(f func(bool, secrets0.Filter) ([]secrets.SecretDetails, error)) *MockSecretAPIClientListSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAPIClientListSecretsCall) DoAndReturn(f func(bool, secrets0.Filter) ([]secrets.SecretDetails, error)) *MockSecretAPIClientListSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveSecret mocks base method.
+func (m *MockSecretAPIClient) RemoveSecret(uri *secrets0.URI, name string, revision *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSecret", uri, name, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSecret indicates an expected call of RemoveSecret.
+func (mr *MockSecretAPIClientMockRecorder) RemoveSecret(uri, name, revision any) *MockSecretAPIClientRemoveSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).RemoveSecret), uri, name, revision)
+	return &MockSecretAPIClientRemoveSecretCall{Call: call}
+}
+
+// MockSecretAPIClientRemoveSecretCall wrap *gomock.Call
+type MockSecretAPIClientRemoveSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAPIClientRemoveSecretCall) Return(arg0 error) *MockSecretAPIClientRemoveSecretCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAPIClientRemoveSecretCall) Do(f func(*secrets0.URI, string, *int) error) *MockSecretAPIClientRemoveSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAPIClientRemoveSecretCall) DoAndReturn(f func(*secrets0.URI, string, *int) error) *MockSecretAPIClientRemoveSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeSecret mocks base method.
+func (m *MockSecretAPIClient) RevokeSecret(uri *secrets0.URI, name string, apps []string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSecret", uri, name, apps)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSecret indicates an expected call of RevokeSecret.
+func (mr *MockSecretAPIClientMockRecorder) RevokeSecret(uri, name, apps any) *MockSecretAPIClientRevokeSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).RevokeSecret), uri, name, apps)
+	return &MockSecretAPIClientRevokeSecretCall{Call: call}
+}
+
+// MockSecretAPIClientRevokeSecretCall wrap *gomock.Call
+type MockSecretAPIClientRevokeSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAPIClientRevokeSecretCall) Return(arg0 []error, arg1 error) *MockSecretAPIClientRevokeSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAPIClientRevokeSecretCall) Do(f func(*secrets0.URI, string, []string) ([]error, error)) *MockSecretAPIClientRevokeSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAPIClientRevokeSecretCall) DoAndReturn(f func(*secrets0.URI, string, []string) ([]error, error)) *MockSecretAPIClientRevokeSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSecret mocks base method.
+func (m *MockSecretAPIClient) UpdateSecret(uri *secrets0.URI, name string, autoPrune *bool, newName, description string, data map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecret", uri, name, autoPrune, newName, description, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecret indicates an expected call of UpdateSecret.
+func (mr *MockSecretAPIClientMockRecorder) UpdateSecret(uri, name, autoPrune, newName, description, data any) *MockSecretAPIClientUpdateSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).UpdateSecret), uri, name, autoPrune, newName, description, data)
+	return &MockSecretAPIClientUpdateSecretCall{Call: call}
+}
+
+// MockSecretAPIClientUpdateSecretCall wrap *gomock.Call
+type MockSecretAPIClientUpdateSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretAPIClientUpdateSecretCall) Return(arg0 error) *MockSecretAPIClientUpdateSecretCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretAPIClientUpdateSecretCall) Do(f func(*secrets0.URI, string, *bool, string, string, map[string]string) error) *MockSecretAPIClientUpdateSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretAPIClientUpdateSecretCall) DoAndReturn(f func(*secrets0.URI, string, *bool, string, string, map[string]string) error) *MockSecretAPIClientUpdateSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSecretBackendAPIClient is a mock of SecretBackendAPIClient interface.
+type MockSecretBackendAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretBackendAPIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretBackendAPIClientMockRecorder is the mock recorder for MockSecretBackendAPIClient.
+type MockSecretBackendAPIClientMockRecorder struct {
+	mock *MockSecretBackendAPIClient
+}
+
+// NewMockSecretBackendAPIClient creates a new mock instance.
+func NewMockSecretBackendAPIClient(ctrl *gomock.Controller) *MockSecretBackendAPIClient {
+	mock := &MockSecretBackendAPIClient{ctrl: ctrl}
+	mock.recorder = &MockSecretBackendAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretBackendAPIClient) EXPECT() *MockSecretBackendAPIClientMockRecorder {
+	return m.recorder
+}
+
+// AddSecretBackend mocks base method.
+func (m *MockSecretBackendAPIClient) AddSecretBackend(backend secretbackends.CreateSecretBackend) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecretBackend", backend)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecretBackend indicates an expected call of AddSecretBackend.
+func (mr *MockSecretBackendAPIClientMockRecorder) AddSecretBackend(backend any) *MockSecretBackendAPIClientAddSecretBackendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretBackend", reflect.TypeOf((*MockSecretBackendAPIClient)(nil).AddSecretBackend), backend)
+	return &MockSecretBackendAPIClientAddSecretBackendCall{Call: call}
+}
+
+// MockSecretBackendAPIClientAddSecretBackendCall wrap *gomock.Call
+type MockSecretBackendAPIClientAddSecretBackendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendAPIClientAddSecretBackendCall) Return(arg0 error) *MockSecretBackendAPIClientAddSecretBackendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendAPIClientAddSecretBackendCall) Do(f func(secretbackends.CreateSecretBackend) error) *MockSecretBackendAPIClientAddSecretBackendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendAPIClientAddSecretBackendCall) DoAndReturn(f func(secretbackends.CreateSecretBackend) error) *MockSecretBackendAPIClientAddSecretBackendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSecretBackends mocks base method.
+func (m *MockSecretBackendAPIClient) ListSecretBackends(names []string, reveal bool) ([]secretbackends.SecretBackend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretBackends", names, reveal)
+	ret0, _ := ret[0].([]secretbackends.SecretBackend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretBackends indicates an expected call of ListSecretBackends.
+func (mr *MockSecretBackendAPIClientMockRecorder) ListSecretBackends(names, reveal any) *MockSecretBackendAPIClientListSecretBackendsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretBackends", reflect.TypeOf((*MockSecretBackendAPIClient)(nil).ListSecretBackends), names, reveal)
+	return &MockSecretBackendAPIClientListSecretBackendsCall{Call: call}
+}
+
+// MockSecretBackendAPIClientListSecretBackendsCall wrap *gomock.Call
+type MockSecretBackendAPIClientListSecretBackendsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendAPIClientListSecretBackendsCall) Return(arg0 []secretbackends.SecretBackend, arg1 error) *MockSecretBackendAPIClientListSecretBackendsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendAPIClientListSecretBackendsCall) Do(f func([]string, bool) ([]secretbackends.SecretBackend, error)) *MockSecretBackendAPIClientListSecretBackendsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendAPIClientListSecretBackendsCall) DoAndReturn(f func([]string, bool) ([]secretbackends.SecretBackend, error)) *MockSecretBackendAPIClientListSecretBackendsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveSecretBackend mocks base method.
+func (m *MockSecretBackendAPIClient) RemoveSecretBackend(name string, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSecretBackend", name, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSecretBackend indicates an expected call of RemoveSecretBackend.
+func (mr *MockSecretBackendAPIClientMockRecorder) RemoveSecretBackend(name, force any) *MockSecretBackendAPIClientRemoveSecretBackendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSecretBackend", reflect.TypeOf((*MockSecretBackendAPIClient)(nil).RemoveSecretBackend), name, force)
+	return &MockSecretBackendAPIClientRemoveSecretBackendCall{Call: call}
+}
+
+// MockSecretBackendAPIClientRemoveSecretBackendCall wrap *gomock.Call
+type MockSecretBackendAPIClientRemoveSecretBackendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendAPIClientRemoveSecretBackendCall) Return(arg0 error) *MockSecretBackendAPIClientRemoveSecretBackendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendAPIClientRemoveSecretBackendCall) Do(f func(string, bool) error) *MockSecretBackendAPIClientRemoveSecretBackendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendAPIClientRemoveSecretBackendCall) DoAndReturn(f func(string, bool) error) *MockSecretBackendAPIClientRemoveSecretBackendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSecretBackend mocks base method.
+func (m *MockSecretBackendAPIClient) UpdateSecretBackend(arg secretbackends.UpdateSecretBackend, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecretBackend", arg, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecretBackend indicates an expected call of UpdateSecretBackend.
+func (mr *MockSecretBackendAPIClientMockRecorder) UpdateSecretBackend(arg, force any) *MockSecretBackendAPIClientUpdateSecretBackendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecretBackend", reflect.TypeOf((*MockSecretBackendAPIClient)(nil).UpdateSecretBackend), arg, force)
+	return &MockSecretBackendAPIClientUpdateSecretBackendCall{Call: call}
+}
+
+// MockSecretBackendAPIClientUpdateSecretBackendCall wrap *gomock.Call
+type MockSecretBackendAPIClientUpdateSecretBackendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendAPIClientUpdateSecretBackendCall) Return(arg0 error) *MockSecretBackendAPIClientUpdateSecretBackendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendAPIClientUpdateSecretBackendCall) Do(f func(secretbackends.UpdateSecretBackend, bool) error) *MockSecretBackendAPIClientUpdateSecretBackendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendAPIClientUpdateSecretBackendCall) DoAndReturn(f func(secretbackends.UpdateSecretBackend, bool) error) *MockSecretBackendAPIClientUpdateSecretBackendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSpaceAPIClient is a mock of SpaceAPIClient interface.
+type MockSpaceAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpaceAPIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockSpaceAPIClientMockRecorder is the mock recorder for MockSpaceAPIClient.
+type MockSpaceAPIClientMockRecorder struct {
+	mock *MockSpaceAPIClient
+}
+
+// NewMockSpaceAPIClient creates a new mock instance.
+func NewMockSpaceAPIClient(ctrl *gomock.Controller) *MockSpaceAPIClient {
+	mock := &MockSpaceAPIClient{ctrl: ctrl}
+	mock.recorder = &MockSpaceAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpaceAPIClient) EXPECT() *MockSpaceAPIClientMockRecorder {
+	return m.recorder
+}
+
+// CreateSpace mocks base method.
+func (m *MockSpaceAPIClient) CreateSpace(name string, cidrs []string, public bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSpace", name, cidrs, public)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSpace indicates an expected call of CreateSpace.
+func (mr *MockSpaceAPIClientMockRecorder) CreateSpace(name, cidrs, public any) *MockSpaceAPIClientCreateSpaceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSpace", reflect.TypeOf((*MockSpaceAPIClient)(nil).CreateSpace), name, cidrs, public)
+	return &MockSpaceAPIClientCreateSpaceCall{Call: call}
+}
+
+// MockSpaceAPIClientCreateSpaceCall wrap *gomock.Call
+type MockSpaceAPIClientCreateSpaceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSpaceAPIClientCreateSpaceCall) Return(arg0 error) *MockSpaceAPIClientCreateSpaceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSpaceAPIClientCreateSpaceCall) Do(f func(string, []string, bool) error) *MockSpaceAPIClientCreateSpaceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSpaceAPIClientCreateSpaceCall) DoAndReturn(f func(string, []string, bool) error) *MockSpaceAPIClientCreateSpaceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MoveSubnets mocks base method.
+func (m *MockSpaceAPIClient) MoveSubnets(space names.SpaceTag, subnets []names.SubnetTag, force bool) (params0.MoveSubnetsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveSubnets", space, subnets, force)
+	ret0, _ := ret[0].(params0.MoveSubnetsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveSubnets indicates an expected call of MoveSubnets.
+func (mr *MockSpaceAPIClientMockRecorder) MoveSubnets(space, subnets, force any) *MockSpaceAPIClientMoveSubnetsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveSubnets", reflect.TypeOf((*MockSpaceAPIClient)(nil).MoveSubnets), space, subnets, force)
+	return &MockSpaceAPIClientMoveSubnetsCall{Call: call}
+}
+
+// MockSpaceAPIClientMoveSubnetsCall wrap *gomock.Call
+type MockSpaceAPIClientMoveSubnetsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSpaceAPIClientMoveSubnetsCall) Return(arg0 params0.MoveSubnetsResult, arg1 error) *MockSpaceAPIClientMoveSubnetsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSpaceAPIClientMoveSubnetsCall) Do(f func(names.SpaceTag, []names.SubnetTag, bool) (params0.MoveSubnetsResult, error)) *MockSpaceAPIClientMoveSubnetsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSpaceAPIClientMoveSubnetsCall) DoAndReturn(f func(names.SpaceTag, []names.SubnetTag, bool) (params0.MoveSubnetsResult, error)) *MockSpaceAPIClientMoveSubnetsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveSpace mocks base method.
+func (m *MockSpaceAPIClient) RemoveSpace(name string, force, dryRun bool) (params0.RemoveSpaceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSpace", name, force, dryRun)
+	ret0, _ := ret[0].(params0.RemoveSpaceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveSpace indicates an expected call of RemoveSpace.
+func (mr *MockSpaceAPIClientMockRecorder) RemoveSpace(name, force, dryRun any) *MockSpaceAPIClientRemoveSpaceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSpace", reflect.TypeOf((*MockSpaceAPIClient)(nil).RemoveSpace), name, force, dryRun)
+	return &MockSpaceAPIClientRemoveSpaceCall{Call: call}
+}
+
+// MockSpaceAPIClientRemoveSpaceCall wrap *gomock.Call
+type MockSpaceAPIClientRemoveSpaceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSpaceAPIClientRemoveSpaceCall) Return(arg0 params0.RemoveSpaceResult, arg1 error) *MockSpaceAPIClientRemoveSpaceCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretAPIClientGrantSecretCall) Do(f func(*secrets0.URI, string, []string) ([]error, error)) *MockSecretAPIClientGrantSecretCall {
+func (c *MockSpaceAPIClientRemoveSpaceCall) Do(f func(string, bool, bool) (params0.RemoveSpaceResult, error)) *MockSpaceAPIClientRemoveSpaceCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretAPIClientGrantSecretCall) DoAndReturn(f func(*secrets0.URI, string, []string) ([]error, error)) *MockSecretAPIClientGrantSecretCall {
+func (c *MockSpaceAPIClientRemoveSpaceCall) DoAndReturn(f func(string, bool, bool) (params0.RemoveSpaceResult, error)) *MockSpaceAPIClientRemoveSpaceCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListSecrets mocks base method.
-func (m *MockSecretAPIClient) ListSecrets(reveal bool, filter secrets0.Filter) ([]secrets.SecretDetails, error) {
+// RenameSpace mocks base method.
+func (m *MockSpaceAPIClient) RenameSpace(oldName, newName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSecrets", reveal, filter)
-	ret0, _ := ret[0].([]secrets.SecretDetails)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RenameSpace", oldName, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListSecrets indicates an expected call of ListSecrets.
-func (mr *MockSecretAPIClientMockRecorder) ListSecrets(reveal, filter any) *MockSecretAPIClientListSecretsCall {
+// RenameSpace indicates an expected call of RenameSpace.
+func (mr *MockSpaceAPIClientMockRecorder) RenameSpace(oldName, newName any) *MockSpaceAPIClientRenameSpaceCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockSecretAPIClient)(nil).ListSecrets), reveal, filter)
-	return &MockSecretAPIClientListSecretsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSpace", reflect.TypeOf((*MockSpaceAPIClient)(nil).RenameSpace), oldName, newName)
+	return &MockSpaceAPIClientRenameSpaceCall{Call: call}
 }
 
-// MockSecretAPIClientListSecretsCall wrap *gomock.Call
-type MockSecretAPIClientListSecretsCall struct {
+// MockSpaceAPIClientRenameSpaceCall wrap *gomock.Call
+type MockSpaceAPIClientRenameSpaceCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretAPIClientListSecretsCall) Return(arg0 []secrets.SecretDetails, arg1 error) *MockSecretAPIClientListSecretsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockSpaceAPIClientRenameSpaceCall) Return(arg0 error) *MockSpaceAPIClientRenameSpaceCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretAPIClientListSecretsCall) Do(f func(bool, secrets0.Filter) ([]secrets.SecretDetails, error)) *MockSecretAPIClientListSecretsCall {
+func (c *MockSpaceAPIClientRenameSpaceCall) Do(f func(string, string) error) *MockSpaceAPIClientRenameSpaceCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretAPIClientListSecretsCall) DoAndReturn(f func(bool, secrets0.Filter) ([]secrets.SecretDetails, error)) *MockSecretAPIClientListSecretsCall {
+func (c *MockSpaceAPIClientRenameSpaceCall) DoAndReturn(f func(string, string) error) *MockSpaceAPIClientRenameSpaceCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RemoveSecret mocks base method.
-func (m *MockSecretAPIClient) RemoveSecret(uri *secrets0.URI, name string, revision *int) error {
+// ShowSpace mocks base method.
+func (m *MockSpaceAPIClient) ShowSpace(name string) (params0.ShowSpaceResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveSecret", uri, name, revision)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ShowSpace", name)
+	ret0, _ := ret[0].(params0.ShowSpaceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveSecret indicates an expected call of RemoveSecret.
-func (mr *MockSecretAPIClientMockRecorder) RemoveSecret(uri, name, revision any) *MockSecretAPIClientRemoveSecretCall {
+// ShowSpace indicates an expected call of ShowSpace.
+func (mr *MockSpaceAPIClientMockRecorder) ShowSpace(name any) *MockSpaceAPIClientShowSpaceCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).RemoveSecret), uri, name, revision)
-	return &MockSecretAPIClientRemoveSecretCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSpace", reflect.TypeOf((*MockSpaceAPIClient)(nil).ShowSpace), name)
+	return &MockSpaceAPIClientShowSpaceCall{Call: call}
 }
 
-// MockSecretAPIClientRemoveSecretCall wrap *gomock.Call
-type MockSecretAPIClientRemoveSecretCall struct {
+// MockSpaceAPIClientShowSpaceCall wrap *gomock.Call
+type MockSpaceAPIClientShowSpaceCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretAPIClientRemoveSecretCall) Return(arg0 error) *MockSecretAPIClientRemoveSecretCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockSpaceAPIClientShowSpaceCall) Return(arg0 params0.ShowSpaceResult, arg1 error) *MockSpaceAPIClientShowSpaceCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretAPIClientRemoveSecretCall) Do(f func(*secrets0.URI, string, *int) error) *MockSecretAPIClientRemoveSecretCall {
+func (c *MockSpaceAPIClientShowSpaceCall) Do(f func(string) (params0.ShowSpaceResult, error)) *MockSpaceAPIClientShowSpaceCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretAPIClientRemoveSecretCall) DoAndReturn(f func(*secrets0.URI, string, *int) error) *MockSecretAPIClientRemoveSecretCall {
+func (c *MockSpaceAPIClientShowSpaceCall) DoAndReturn(f func(string) (params0.ShowSpaceResult, error)) *MockSpaceAPIClientShowSpaceCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RevokeSecret mocks base method.
-func (m *MockSecretAPIClient) RevokeSecret(uri *secrets0.URI, name string, apps []string) ([]error, error) {
+// MockSubnetAPIClient is a mock of SubnetAPIClient interface.
+type MockSubnetAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubnetAPIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockSubnetAPIClientMockRecorder is the mock recorder for MockSubnetAPIClient.
+type MockSubnetAPIClientMockRecorder struct {
+	mock *MockSubnetAPIClient
+}
+
+// NewMockSubnetAPIClient creates a new mock instance.
+func NewMockSubnetAPIClient(ctrl *gomock.Controller) *MockSubnetAPIClient {
+	mock := &MockSubnetAPIClient{ctrl: ctrl}
+	mock.recorder = &MockSubnetAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubnetAPIClient) EXPECT() *MockSubnetAPIClientMockRecorder {
+	return m.recorder
+}
+
+// ListSubnets mocks base method.
+func (m *MockSubnetAPIClient) ListSubnets(spaceTag *names.SpaceTag, zone string) ([]params0.Subnet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokeSecret", uri, name, apps)
-	ret0, _ := ret[0].([]error)
+	ret := m.ctrl.Call(m, "ListSubnets", spaceTag, zone)
+	ret0, _ := ret[0].([]params0.Subnet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RevokeSecret indicates an expected call of RevokeSecret.
-func (mr *MockSecretAPIClientMockRecorder) RevokeSecret(uri, name, apps any) *MockSecretAPIClientRevokeSecretCall {
+// ListSubnets indicates an expected call of ListSubnets.
+func (mr *MockSubnetAPIClientMockRecorder) ListSubnets(spaceTag, zone any) *MockSubnetAPIClientListSubnetsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).RevokeSecret), uri, name, apps)
-	return &MockSecretAPIClientRevokeSecretCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnets", reflect.TypeOf((*MockSubnetAPIClient)(nil).ListSubnets), spaceTag, zone)
+	return &MockSubnetAPIClientListSubnetsCall{Call: call}
 }
 
-// MockSecretAPIClientRevokeSecretCall wrap *gomock.Call
-type MockSecretAPIClientRevokeSecretCall struct {
+// MockSubnetAPIClientListSubnetsCall wrap *gomock.Call
+type MockSubnetAPIClientListSubnetsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretAPIClientRevokeSecretCall) Return(arg0 []error, arg1 error) *MockSecretAPIClientRevokeSecretCall {
+func (c *MockSubnetAPIClientListSubnetsCall) Return(arg0 []params0.Subnet, arg1 error) *MockSubnetAPIClientListSubnetsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretAPIClientRevokeSecretCall) Do(f func(*secrets0.URI, string, []string) ([]error, error)) *MockSecretAPIClientRevokeSecretCall {
+func (c *MockSubnetAPIClientListSubnetsCall) Do(f func(*names.SpaceTag, string) ([]params0.Subnet, error)) *MockSubnetAPIClientListSubnetsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretAPIClientRevokeSecretCall) DoAndReturn(f func(*secrets0.URI, string, []string) ([]error, error)) *MockSecretAPIClientRevokeSecretCall {
+func (c *MockSubnetAPIClientListSubnetsCall) DoAndReturn(f func(*names.SpaceTag, string) ([]params0.Subnet, error)) *MockSubnetAPIClientListSubnetsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateSecret mocks base method.
-func (m *MockSecretAPIClient) UpdateSecret(uri *secrets0.URI, name string, autoPrune *bool, newName, description string, data map[string]string) error {
+// SubnetsByCIDR mocks base method.
+func (m *MockSubnetAPIClient) SubnetsByCIDR(cidrs []string) ([]params0.SubnetsResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSecret", uri, name, autoPrune, newName, description, data)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SubnetsByCIDR", cidrs)
+	ret0, _ := ret[0].([]params0.SubnetsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateSecret indicates an expected call of UpdateSecret.
-func (mr *MockSecretAPIClientMockRecorder) UpdateSecret(uri, name, autoPrune, newName, description, data any) *MockSecretAPIClientUpdateSecretCall {
+// SubnetsByCIDR indicates an expected call of SubnetsByCIDR.
+func (mr *MockSubnetAPIClientMockRecorder) SubnetsByCIDR(cidrs any) *MockSubnetAPIClientSubnetsByCIDRCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MockSecretAPIClient)(nil).UpdateSecret), uri, name, autoPrune, newName, description, data)
-	return &MockSecretAPIClientUpdateSecretCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetsByCIDR", reflect.TypeOf((*MockSubnetAPIClient)(nil).SubnetsByCIDR), cidrs)
+	return &MockSubnetAPIClientSubnetsByCIDRCall{Call: call}
 }
 
-// MockSecretAPIClientUpdateSecretCall wrap *gomock.Call
-type MockSecretAPIClientUpdateSecretCall struct {
+// MockSubnetAPIClientSubnetsByCIDRCall wrap *gomock.Call
+type MockSubnetAPIClientSubnetsByCIDRCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretAPIClientUpdateSecretCall) Return(arg0 error) *MockSecretAPIClientUpdateSecretCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockSubnetAPIClientSubnetsByCIDRCall) Return(arg0 []params0.SubnetsResult, arg1 error) *MockSubnetAPIClientSubnetsByCIDRCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretAPIClientUpdateSecretCall) Do(f func(*secrets0.URI, string, *bool, string, string, map[string]string) error) *MockSecretAPIClientUpdateSecretCall {
+func (c *MockSubnetAPIClientSubnetsByCIDRCall) Do(f func([]string) ([]params0.SubnetsResult, error)) *MockSubnetAPIClientSubnetsByCIDRCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretAPIClientUpdateSecretCall) DoAndReturn(f func(*secrets0.URI, string, *bool, string, string, map[string]string) error) *MockSecretAPIClientUpdateSecretCall {
+func (c *MockSubnetAPIClientSubnetsByCIDRCall) DoAndReturn(f func([]string) ([]params0.SubnetsResult, error)) *MockSubnetAPIClientSubnetsByCIDRCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1948,6 +3064,83 @@ func (c *MockJaasAPIClientAddRoleCall) DoAndReturn(f func(*params.AddRoleRequest
 	return c
 }
 
+// AddServiceAccount mocks base method.
+func (m *MockJaasAPIClient) AddServiceAccount(req *params.AddServiceAccountRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceAccount", req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceAccount indicates an expected call of AddServiceAccount.
+func (mr *MockJaasAPIClientMockRecorder) AddServiceAccount(req any) *MockJaasAPIClientAddServiceAccountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceAccount", reflect.TypeOf((*MockJaasAPIClient)(nil).AddServiceAccount), req)
+	return &MockJaasAPIClientAddServiceAccountCall{Call: call}
+}
+
+// MockJaasAPIClientAddServiceAccountCall wrap *gomock.Call
+type MockJaasAPIClientAddServiceAccountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientAddServiceAccountCall) Return(arg0 error) *MockJaasAPIClientAddServiceAccountCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientAddServiceAccountCall) Do(f func(*params.AddServiceAccountRequest) error) *MockJaasAPIClientAddServiceAccountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientAddServiceAccountCall) DoAndReturn(f func(*params.AddServiceAccountRequest) error) *MockJaasAPIClientAddServiceAccountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CopyServiceAccountCredential mocks base method.
+func (m *MockJaasAPIClient) CopyServiceAccountCredential(req *params.CopyServiceAccountCredentialRequest) (*params0.UpdateCredentialResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyServiceAccountCredential", req)
+	ret0, _ := ret[0].(*params0.UpdateCredentialResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyServiceAccountCredential indicates an expected call of CopyServiceAccountCredential.
+func (mr *MockJaasAPIClientMockRecorder) CopyServiceAccountCredential(req any) *MockJaasAPIClientCopyServiceAccountCredentialCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyServiceAccountCredential", reflect.TypeOf((*MockJaasAPIClient)(nil).CopyServiceAccountCredential), req)
+	return &MockJaasAPIClientCopyServiceAccountCredentialCall{Call: call}
+}
+
+// MockJaasAPIClientCopyServiceAccountCredentialCall wrap *gomock.Call
+type MockJaasAPIClientCopyServiceAccountCredentialCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientCopyServiceAccountCredentialCall) Return(arg0 *params0.UpdateCredentialResult, arg1 error) *MockJaasAPIClientCopyServiceAccountCredentialCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientCopyServiceAccountCredentialCall) Do(f func(*params.CopyServiceAccountCredentialRequest) (*params0.UpdateCredentialResult, error)) *MockJaasAPIClientCopyServiceAccountCredentialCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientCopyServiceAccountCredentialCall) DoAndReturn(f func(*params.CopyServiceAccountCredentialRequest) (*params0.UpdateCredentialResult, error)) *MockJaasAPIClientCopyServiceAccountCredentialCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetGroup mocks base method.
 func (m *MockJaasAPIClient) GetGroup(req *params.GetGroupRequest) (params.GetGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -2065,6 +3258,45 @@ func (c *MockJaasAPIClientListRelationshipTuplesCall) DoAndReturn(f func(*params
 	return c
 }
 
+// ListServiceAccountCredentials mocks base method.
+func (m *MockJaasAPIClient) ListServiceAccountCredentials(req *params.ListServiceAccountCredentialsRequest) (*params0.CredentialContentResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceAccountCredentials", req)
+	ret0, _ := ret[0].(*params0.CredentialContentResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceAccountCredentials indicates an expected call of ListServiceAccountCredentials.
+func (mr *MockJaasAPIClientMockRecorder) ListServiceAccountCredentials(req any) *MockJaasAPIClientListServiceAccountCredentialsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccountCredentials", reflect.TypeOf((*MockJaasAPIClient)(nil).ListServiceAccountCredentials), req)
+	return &MockJaasAPIClientListServiceAccountCredentialsCall{Call: call}
+}
+
+// MockJaasAPIClientListServiceAccountCredentialsCall wrap *gomock.Call
+type MockJaasAPIClientListServiceAccountCredentialsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJaasAPIClientListServiceAccountCredentialsCall) Return(arg0 *params0.CredentialContentResults, arg1 error) *MockJaasAPIClientListServiceAccountCredentialsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJaasAPIClientListServiceAccountCredentialsCall) Do(f func(*params.ListServiceAccountCredentialsRequest) (*params0.CredentialContentResults, error)) *MockJaasAPIClientListServiceAccountCredentialsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJaasAPIClientListServiceAccountCredentialsCall) DoAndReturn(f func(*params.ListServiceAccountCredentialsRequest) (*params0.CredentialContentResults, error)) *MockJaasAPIClientListServiceAccountCredentialsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveGroup mocks base method.
 func (m *MockJaasAPIClient) RemoveGroup(req *params.RemoveGroupRequest) error {
 	m.ctrl.T.Helper()
@@ -2576,3 +3808,144 @@ func (c *MockCharmhubClientInfoCall) DoAndReturn(f func(context.Context, string,
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockActionAPIClient is a mock of ActionAPIClient interface.
+type MockActionAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockActionAPIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockActionAPIClientMockRecorder is the mock recorder for MockActionAPIClient.
+type MockActionAPIClientMockRecorder struct {
+	mock *MockActionAPIClient
+}
+
+// NewMockActionAPIClient creates a new mock instance.
+func NewMockActionAPIClient(ctrl *gomock.Controller) *MockActionAPIClient {
+	mock := &MockActionAPIClient{ctrl: ctrl}
+	mock.recorder = &MockActionAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActionAPIClient) EXPECT() *MockActionAPIClientMockRecorder {
+	return m.recorder
+}
+
+// ApplicationCharmActions mocks base method.
+func (m *MockActionAPIClient) ApplicationCharmActions(appName string) (map[string]action.ActionSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationCharmActions", appName)
+	ret0, _ := ret[0].(map[string]action.ActionSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationCharmActions indicates an expected call of ApplicationCharmActions.
+func (mr *MockActionAPIClientMockRecorder) ApplicationCharmActions(appName any) *MockActionAPIClientApplicationCharmActionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationCharmActions", reflect.TypeOf((*MockActionAPIClient)(nil).ApplicationCharmActions), appName)
+	return &MockActionAPIClientApplicationCharmActionsCall{Call: call}
+}
+
+// MockActionAPIClientApplicationCharmActionsCall wrap *gomock.Call
+type MockActionAPIClientApplicationCharmActionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockActionAPIClientApplicationCharmActionsCall) Return(arg0 map[string]action.ActionSpec, arg1 error) *MockActionAPIClientApplicationCharmActionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockActionAPIClientApplicationCharmActionsCall) Do(f func(string) (map[string]action.ActionSpec, error)) *MockActionAPIClientApplicationCharmActionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockActionAPIClientApplicationCharmActionsCall) DoAndReturn(f func(string) (map[string]action.ActionSpec, error)) *MockActionAPIClientApplicationCharmActionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EnqueueOperation mocks base method.
+func (m *MockActionAPIClient) EnqueueOperation(actions []action.Action) (action.EnqueuedActions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueOperation", actions)
+	ret0, _ := ret[0].(action.EnqueuedActions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueOperation indicates an expected call of EnqueueOperation.
+func (mr *MockActionAPIClientMockRecorder) EnqueueOperation(actions any) *MockActionAPIClientEnqueueOperationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueOperation", reflect.TypeOf((*MockActionAPIClient)(nil).EnqueueOperation), actions)
+	return &MockActionAPIClientEnqueueOperationCall{Call: call}
+}
+
+// MockActionAPIClientEnqueueOperationCall wrap *gomock.Call
+type MockActionAPIClientEnqueueOperationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockActionAPIClientEnqueueOperationCall) Return(arg0 action.EnqueuedActions, arg1 error) *MockActionAPIClientEnqueueOperationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockActionAPIClientEnqueueOperationCall) Do(f func([]action.Action) (action.EnqueuedActions, error)) *MockActionAPIClientEnqueueOperationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockActionAPIClientEnqueueOperationCall) DoAndReturn(f func([]action.Action) (action.EnqueuedActions, error)) *MockActionAPIClientEnqueueOperationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Operation mocks base method.
+func (m *MockActionAPIClient) Operation(ID string) (action.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Operation", ID)
+	ret0, _ := ret[0].(action.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Operation indicates an expected call of Operation.
+func (mr *MockActionAPIClientMockRecorder) Operation(ID any) *MockActionAPIClientOperationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Operation", reflect.TypeOf((*MockActionAPIClient)(nil).Operation), ID)
+	return &MockActionAPIClientOperationCall{Call: call}
+}
+
+// MockActionAPIClientOperationCall wrap *gomock.Call
+type MockActionAPIClientOperationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockActionAPIClientOperationCall) Return(arg0 action.Operation, arg1 error) *MockActionAPIClientOperationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockActionAPIClientOperationCall) Do(f func(string) (action.Operation, error)) *MockActionAPIClientOperationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockActionAPIClientOperationCall) DoAndReturn(f func(string) (action.Operation, error)) *MockActionAPIClientOperationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -5,17 +5,21 @@ package juju
 
 import (
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
+	"github.com/juju/charm/v12"
 	"github.com/juju/errors"
 	"github.com/juju/juju/api/base"
+	apibundle "github.com/juju/juju/api/client/bundle"
 	"github.com/juju/juju/api/client/modelconfig"
 	"github.com/juju/juju/api/client/modelmanager"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v5"
+	"gopkg.in/yaml.v3"
 )
 
 // TransactionError is returned when a transaction is aborted.
@@ -52,6 +56,33 @@ type ReadModelResponse struct {
 	ModelConstraints constraints.Value
 }
 
+// ModelSummary holds the identifying details of a model.
+type ModelSummary struct {
+	Name  string
+	UUID  string
+	Owner string
+}
+
+// ExportModelBundleInput holds the options of the export of a model as
+// a bundle.
+type ExportModelBundleInput struct {
+	ModelUUID string
+	// IncludeCharmDefaults includes the default values of the charm
+	// config in the options of the applications.
+	IncludeCharmDefaults bool
+	// StripMachinePlacements removes the machines section of the bundle
+	// and the placement directives of the applications.
+	StripMachinePlacements bool
+}
+
+// ExportModelBundleResponse holds a model exported as a bundle.
+type ExportModelBundleResponse struct {
+	// Bundle is the YAML of the bundle, as output by `juju export-bundle`.
+	Bundle string
+	// Applications holds the applications of the base bundle, by name.
+	Applications map[string]*charm.ApplicationSpec
+}
+
 // ReadModelStatusResponse contains the status of a model.
 type ReadModelStatusResponse struct {
 	ModelStatus base.ModelStatus
@@ -69,6 +100,13 @@ type UpdateModelInput struct {
 
 type DestroyModelInput struct {
 	UUID string
+	// DestroyStorage controls whether the storage of the model is
+	// destroyed or released. It defaults to true.
+	DestroyStorage *bool
+	// Force removes the model ignoring errors, MaxWait is how long
+	// each step of the forced removal waits before moving on.
+	Force   bool
+	MaxWait *time.Duration
 }
 
 type GrantModelInput struct {
@@ -91,6 +129,29 @@ type DestroyAccessModelInput struct {
 	Access    string
 }
 
+// ModelDefaultsInput identifies the cloud, and optionally the region
+// of the cloud, that model defaults are read from or written to.
+type ModelDefaultsInput struct {
+	CloudName   string
+	CloudRegion string
+}
+
+type ReadModelDefaultsResponse struct {
+	// Config holds the defaults set for the cloud or region only,
+	// not the inherited or built-in values.
+	Config map[string]interface{}
+}
+
+type SetModelDefaultsInput struct {
+	ModelDefaultsInput
+	Config map[string]string
+}
+
+type UnsetModelDefaultsInput struct {
+	ModelDefaultsInput
+	Keys []string
+}
+
 func newModelsClient(sc SharedClient) *modelsClient {
 	return &modelsClient{
 		SharedClient: sc,
@@ -300,6 +361,142 @@ func (c *modelsClient) ReadModelStatus(modelUUID string) (*ReadModelStatusRespon
 	}, nil
 }
 
+// ListModels returns the models the current user has access to.
+func (c *modelsClient) ListModels() ([]ModelSummary, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+	summaries, err := client.ListModelSummaries(conn.AuthTag().Id(), false)
+	if err != nil {
+		return nil, errors.Annotate(err, "listing models")
+	}
+	models := make([]ModelSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		if summary.Error != nil {
+			return nil, errors.Annotate(summary.Error, "listing models")
+		}
+		models = append(models, ModelSummary{
+			Name:  summary.Name,
+			UUID:  summary.UUID,
+			Owner: summary.Owner,
+		})
+	}
+	return models, nil
+}
+
+// ReadModelFullStatus returns the full status of a model, as shown by
+// `juju status`.
+func (c *modelsClient) ReadModelFullStatus(modelUUID string) (*params.FullStatus, error) {
+	conn, err := c.GetConnection(&modelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.ModelStatus(modelUUID, conn)
+	if err != nil {
+		return nil, errors.Annotatef(err, "fetching status of model %q", modelUUID)
+	}
+	return status, nil
+}
+
+// ExportModelBundle exports a model as a bundle, as `juju export-bundle`
+// does.
+func (c *modelsClient) ExportModelBundle(input ExportModelBundleInput) (*ExportModelBundleResponse, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apibundle.NewClient(conn)
+	bundle, err := client.ExportBundle(input.IncludeCharmDefaults, false)
+	if err != nil {
+		return nil, errors.Annotatef(err, "exporting model %q", input.ModelUUID)
+	}
+	if input.StripMachinePlacements {
+		bundle, err = stripMachinePlacements(bundle)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := charm.ReadBundleData(strings.NewReader(bundle))
+	if err != nil {
+		return nil, errors.Annotate(err, "parsing exported bundle")
+	}
+	return &ExportModelBundleResponse{
+		Bundle:       bundle,
+		Applications: data.Applications,
+	}, nil
+}
+
+// stripMachinePlacements removes the machines section from every document
+// of a bundle, and the placement directives from its applications. The
+// rest of the bundle is kept as is, only its indentation may change.
+func stripMachinePlacements(bundle string) (string, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(bundle))
+	var documents []*yaml.Node
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); err == io.EOF {
+			break
+		} else if err != nil {
+			return "", errors.Annotate(err, "parsing exported bundle")
+		}
+		documents = append(documents, &document)
+	}
+
+	var b strings.Builder
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	for _, document := range documents {
+		if len(document.Content) == 1 && document.Content[0].Kind == yaml.MappingNode {
+			root := document.Content[0]
+			removeMappingKey(root, "machines")
+			if applications := mappingValue(root, "applications"); applications != nil && applications.Kind == yaml.MappingNode {
+				for i := 1; i < len(applications.Content); i += 2 {
+					if applications.Content[i].Kind == yaml.MappingNode {
+						removeMappingKey(applications.Content[i], "to")
+					}
+				}
+			}
+		}
+		if err := encoder.Encode(document); err != nil {
+			return "", errors.Annotate(err, "encoding exported bundle")
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return "", errors.Annotate(err, "encoding exported bundle")
+	}
+	return b.String(), nil
+}
+
+// mappingValue returns the value of a key of a YAML mapping, or nil if
+// the key is not found.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// removeMappingKey removes a key and its value from a YAML mapping.
+func removeMappingKey(mapping *yaml.Node, key string) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
+
 func (c *modelsClient) UpdateModel(input UpdateModelInput) error {
 	conn, err := c.GetConnection(&input.UUID)
 	if err != nil {
@@ -370,12 +567,18 @@ func (c *modelsClient) DestroyModel(input DestroyModelInput) error {
 	client := modelmanager.NewClient(conn)
 
 	maxWait := 10 * time.Minute
+	if input.MaxWait != nil {
+		maxWait = *input.MaxWait
+	}
 	timeout := 30 * time.Minute
 
 	tag := names.NewModelTag(input.UUID)
 
 	destroyStorage := true
-	forceDestroy := false
+	if input.DestroyStorage != nil {
+		destroyStorage = *input.DestroyStorage
+	}
+	forceDestroy := input.Force
 
 	err = client.DestroyModel(tag, &destroyStorage, &forceDestroy, &maxWait, &timeout)
 	if err != nil {
@@ -453,3 +656,75 @@ func (c *modelsClient) DestroyAccessModel(input DestroyAccessModelInput) error {
 
 	return nil
 }
+
+// ReadModelDefaults returns the model defaults set for a cloud or, if a
+// region is given, for a region of the cloud.
+func (c *modelsClient) ReadModelDefaults(input ModelDefaultsInput) (*ReadModelDefaultsResponse, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+
+	defaults, err := client.ModelDefaults(input.CloudName)
+	if err != nil {
+		return nil, typedError(err)
+	}
+
+	config := make(map[string]interface{})
+	for key, value := range defaults {
+		if input.CloudRegion == "" {
+			// Values set for the cloud are reported at the
+			// controller level.
+			if value.Controller != nil {
+				config[key] = value.Controller
+			}
+			continue
+		}
+		for _, region := range value.Regions {
+			if region.Name == input.CloudRegion {
+				config[key] = region.Value
+			}
+		}
+	}
+	return &ReadModelDefaultsResponse{Config: config}, nil
+}
+
+// SetModelDefaults sets model defaults for a cloud or a region of the
+// cloud. Models created afterwards, and existing models which do not
+// override the keys, use these values.
+func (c *modelsClient) SetModelDefaults(input SetModelDefaultsInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+
+	config := make(map[string]interface{}, len(input.Config))
+	for key, value := range input.Config {
+		config[key] = value
+	}
+	return client.SetModelDefaults(input.CloudName, input.CloudRegion, config)
+}
+
+// UnsetModelDefaults removes model defaults from a cloud or a region of
+// the cloud.
+func (c *modelsClient) UnsetModelDefaults(input UnsetModelDefaultsInput) error {
+	if len(input.Keys) == 0 {
+		return nil
+	}
+
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+
+	return client.UnsetModelDefaults(input.CloudName, input.CloudRegion, input.Keys...)
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStripMachinePlacements(t *testing.T) {
+	bundle := `default-base: ubuntu@22.04/stable
+applications:
+  mysql:
+    charm: mysql
+    channel: 8.0/stable
+    revision: 151
+    num_units: 1
+    to:
+    - "0"
+    constraints: arch=amd64
+  wordpress:
+    charm: wordpress
+    num_units: 2
+    to:
+    - "1"
+    - lxd:0
+machines:
+  "0":
+    constraints: arch=amd64
+  "1": {}
+relations:
+- - wordpress:database
+  - mysql:database
+--- # overlay.yaml
+applications:
+  mysql:
+    offers:
+      mysql:
+        endpoints:
+        - database
+`
+
+	stripped, err := stripMachinePlacements(bundle)
+	require.NoError(t, err)
+	assert.Equal(t, `default-base: ubuntu@22.04/stable
+applications:
+  mysql:
+    charm: mysql
+    channel: 8.0/stable
+    revision: 151
+    num_units: 1
+    constraints: arch=amd64
+  wordpress:
+    charm: wordpress
+    num_units: 2
+relations:
+  - - wordpress:database
+    - mysql:database
+---
+# overlay.yaml
+applications:
+  mysql:
+    offers:
+      mysql:
+        endpoints:
+          - database
+`, stripped)
+}
+
+func TestStripMachinePlacementsInvalidYAML(t *testing.T) {
+	_, err := stripMachinePlacements("applications: [")
+	assert.ErrorContains(t, err, "parsing exported bundle")
+}
+
+type ModelSuite struct {
+	suite.Suite
+	JujuSuite
+}
+
+func (s *ModelSuite) setupMocks(t *testing.T) *gomock.Controller {
+	ctlr := s.JujuSuite.setupMocks(t)
+	s.mockSharedClient.EXPECT().GetConnection(nil).Return(s.mockConnection, nil).AnyTimes()
+	s.mockConnection.EXPECT().AuthTag().Return(names.NewUserTag("admin")).AnyTimes()
+	s.mockConnection.EXPECT().BestFacadeVersion("ModelManager").Return(9).AnyTimes()
+	return ctlr
+}
+
+func (s *ModelSuite) expectListModelSummaries(results ...params.ModelSummaryResult) {
+	s.mockConnection.EXPECT().APICall("ModelManager", 9, "", "ListModelSummaries", params.ModelSummariesRequest{
+		UserTag: "user-admin",
+	}, gomock.Any()).DoAndReturn(func(_ string, _ int, _, _ string, _, response any) error {
+		*(response.(*params.ModelSummaryResults)) = params.ModelSummaryResults{Results: results}
+		return nil
+	})
+}
+
+func (s *ModelSuite) TestListModels() {
+	defer s.setupMocks(s.T()).Finish()
+	s.expectListModelSummaries(
+		params.ModelSummaryResult{Result: &params.ModelSummary{
+			Name:     "controller",
+			UUID:     "27c4fd6f-9a9b-4f2c-8d7e-2a5d1e6d4b0c",
+			OwnerTag: "user-admin",
+			CloudTag: "cloud-localhost",
+		}},
+		params.ModelSummaryResult{Result: &params.ModelSummary{
+			Name:     "db",
+			UUID:     "fff19e46-8a0b-4a52-8e1d-7b16a40f8f2a",
+			OwnerTag: "user-bob",
+			CloudTag: "cloud-localhost",
+		}},
+	)
+	client := newModelsClient(s.mockSharedClient)
+
+	models, err := client.ListModels()
+	s.Require().NoError(err)
+	s.Assert().Equal([]ModelSummary{
+		{Name: "controller", UUID: "27c4fd6f-9a9b-4f2c-8d7e-2a5d1e6d4b0c", Owner: "admin"},
+		{Name: "db", UUID: "fff19e46-8a0b-4a52-8e1d-7b16a40f8f2a", Owner: "bob"},
+	}, models)
+}
+
+func (s *ModelSuite) TestListModelsError() {
+	defer s.setupMocks(s.T()).Finish()
+	s.expectListModelSummaries(params.ModelSummaryResult{
+		Error: &params.Error{Message: "permission denied", Code: params.CodeUnauthorized},
+	})
+	client := newModelsClient(s.mockSharedClient)
+
+	_, err := client.ListModels()
+	s.Assert().ErrorContains(err, "permission denied")
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestModelSuite(t *testing.T) {
+	suite.Run(t, new(ModelSuite))
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/juju/errors"
+	"github.com/juju/juju/api"
 	apiapplication "github.com/juju/juju/api/client/application"
 	"github.com/juju/juju/api/client/applicationoffers"
 	apiclient "github.com/juju/juju/api/client/client"
@@ -35,6 +36,10 @@ var RemoteAppNotFoundError = errors.ConstError("remote-app-not-found")
 
 type offersClient struct {
 	SharedClient
+
+	// dialSourceController opens a connection to the controller
+	// hosting an offer consumed across controllers.
+	dialSourceController func(ControllerConfiguration) (api.Connection, error)
 }
 
 // CreateOfferInput represents input for creating an offer.
@@ -44,6 +49,10 @@ type CreateOfferInput struct {
 	ModelUUID       string
 	OfferOwner      string
 	Name            string
+	// AppAvailableTimeout is the time to wait for the application to be
+	// available before creating the offer. OfferAppAvailableTimeout is
+	// used if zero.
+	AppAvailableTimeout time.Duration
 }
 
 // CreateOfferResponse represents the response from creating an offer.
@@ -82,6 +91,11 @@ type ConsumeRemoteOfferInput struct {
 	ModelUUID      string
 	OfferURL       string
 	RemoteAppAlias string
+	// SourceController holds the addresses and credentials of the
+	// controller hosting the offer, when the offer URL is prefixed with
+	// the name of another controller, e.g. "ctrl:admin/model.offer".
+	// If nil, the controller is read from the local Juju client store.
+	SourceController *ControllerConfiguration
 }
 
 // ConsumeRemoteOfferResponse represents the response from consuming a remote offer.
@@ -97,6 +111,9 @@ type ReadRemoteAppInput struct {
 
 // ReadRemoteAppResponse represents the response from reading a remote app.
 type ReadRemoteAppResponse struct {
+	// OfferURL is the URL of the consumed offer, prefixed with the name
+	// of the controller hosting it when Juju recorded it.
+	OfferURL string
 }
 
 // RemoveRemoteAppInput represents input for removing a remote app.
@@ -115,6 +132,9 @@ type GrantRevokeOfferInput struct {
 func newOffersClient(sc SharedClient) *offersClient {
 	return &offersClient{
 		SharedClient: sc,
+		dialSourceController: func(config ControllerConfiguration) (api.Connection, error) {
+			return dialController(config, "")
+		},
 	}
 }
 
@@ -144,7 +164,11 @@ func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse
 	applicationClient := apiapplication.NewClient(modelConn)
 
 	// wait for the app to be available
-	ctx, cancel := context.WithTimeout(context.Background(), OfferAppAvailableTimeout)
+	timeout := input.AppAvailableTimeout
+	if timeout == 0 {
+		timeout = OfferAppAvailableTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	err = WaitForAppsAvailable(ctx, applicationClient, []string{input.ApplicationName}, OfferApiTickWait)
@@ -239,6 +263,35 @@ func (c offersClient) ReadOffer(input *ReadOfferInput) (*ReadOfferResponse, erro
 	return &response, nil
 }
 
+// ListOffers returns the URLs of the offers hosted by the model, sorted
+// by offer name.
+func (c offersClient) ListOffers(modelUUID string) ([]string, error) {
+	owner, modelName, err := c.ModelOwnerAndName(modelUUID)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := c.GetConnection(&modelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.ModelStatus(modelUUID, conn)
+	if err != nil {
+		return nil, errors.Annotatef(err, "fetching status of model %q", modelUUID)
+	}
+	offerNames := make([]string, 0, len(status.Offers))
+	for name := range status.Offers {
+		offerNames = append(offerNames, name)
+	}
+	slices.Sort(offerNames)
+	offerURLs := make([]string, 0, len(offerNames))
+	for _, name := range offerNames {
+		offerURLs = append(offerURLs, crossmodel.MakeURL(owner, modelName, name, ""))
+	}
+	return offerURLs, nil
+}
+
 // DestroyOffer destroys offer managed by the offer resource.
 func (c offersClient) DestroyOffer(input *DestroyOfferInput) error {
 	conn, err := c.GetConnection(nil)
@@ -342,28 +395,39 @@ func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*Consu
 	}
 	// input.RemoteAppAlias can be empty to use the default offer name.
 
-	modelConn, err := c.GetConnection(&input.ModelUUID)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = modelConn.Close() }()
-	conn, err := c.GetConnection(nil)
+	url, err := crossmodel.ParseOfferURL(input.OfferURL)
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = conn.Close() }()
 
-	offersClient := applicationoffers.NewClient(conn)
-	client := apiapplication.NewClient(modelConn)
+	if url.HasEndpoint() {
+		return nil, fmt.Errorf("saas offer %q shouldn't include endpoint", input.OfferURL)
+	}
 
-	url, err := crossmodel.ParseOfferURL(input.OfferURL)
+	modelConn, err := c.GetConnection(&input.ModelUUID)
 	if err != nil {
 		return nil, err
 	}
+	defer func() { _ = modelConn.Close() }()
 
-	if url.HasEndpoint() {
-		return nil, fmt.Errorf("saas offer %q shouldn't include endpoint", input.OfferURL)
+	// The consume details of an offer hosted on another controller
+	// must be fetched from that controller.
+	var conn api.Connection
+	if url.Source != "" {
+		conn, err = c.dialSourceController(sourceControllerConfig(url.Source, input.SourceController))
+		if err != nil {
+			return nil, errors.Annotatef(err, "connecting to controller %q hosting offer %q", url.Source, input.OfferURL)
+		}
+	} else {
+		conn, err = c.GetConnection(nil)
+		if err != nil {
+			return nil, err
+		}
 	}
+	defer func() { _ = conn.Close() }()
+
+	offersClient := applicationoffers.NewClient(conn)
+	client := apiapplication.NewClient(modelConn)
 
 	consumeDetails, err := offersClient.GetConsumeDetails(url.AsLocal().String())
 	if err != nil {
@@ -375,15 +439,9 @@ func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*Consu
 		return nil, err
 	}
 
-	// The offer URL should not have a source, as that would indicate a cross-controller
-	// relation which is not strictly supported. Support for cross-controller relations
-	// would require some changes above to identify if the URL is pointing to a different
-	// controller than the one we are currently connected to and fetch the consume details
-	// from there instead.
-	if offerURL.Source != "" {
-		return nil, fmt.Errorf("offer URL %q should not have a source", consumeDetails.Offer.OfferURL)
-	}
-
+	// Keep the source controller in the offer URL, so that the remote
+	// application records where the offer is hosted.
+	offerURL.Source = url.Source
 	consumeDetails.Offer.OfferURL = offerURL.String()
 
 	consumeArgs := crossmodel.ConsumeApplicationArgs{
@@ -456,16 +514,15 @@ func (c offersClient) ReadRemoteApp(input *ReadRemoteAppInput) (*ReadRemoteAppRe
 		return nil, errors.WithType(errors.New("remote app not found"), RemoteAppNotFoundError)
 	}
 
-	for appName := range remoteApplications {
-		if appName == input.RemoteAppName {
-			return &ReadRemoteAppResponse{}, nil
-		}
+	remoteApp, ok := remoteApplications[input.RemoteAppName]
+	if !ok {
+		return nil, errors.WithType(errors.New("remote app not found"), RemoteAppNotFoundError)
 	}
 
-	return nil, errors.WithType(errors.New("remote app not found"), RemoteAppNotFoundError)
+	return &ReadRemoteAppResponse{OfferURL: remoteApp.OfferURL}, nil
 }
 
-// RemoveRemoteApp allows the integration resource to destroy the offers managed by the offer resource.
+// RemoveRemoteApp removes a consumed offer (SAAS) from a model.
 func (c offersClient) RemoveRemoteApp(input *RemoveRemoteAppInput) error {
 	conn, err := c.GetConnection(&input.ModelUUID)
 	if err != nil {
@@ -570,15 +627,28 @@ func (c offersClient) RevokeOffer(input *GrantRevokeOfferInput) error {
 	return nil
 }
 
+// sourceControllerConfig returns the configuration used to connect to
+// the named controller hosting an offer. Without explicit addresses or
+// credentials, the controller and its account are read from the local
+// Juju client store.
+func sourceControllerConfig(name string, config *ControllerConfiguration) ControllerConfiguration {
+	if config == nil {
+		return ControllerConfiguration{ControllerName: name}
+	}
+	source := *config
+	if source.ControllerName == "" {
+		source.ControllerName = name
+	}
+	return source
+}
+
 // removeOfferURLSource removes the source field from the offer URL.
 // The source represents the source controller of the offer.
 //
 // The Juju CLI sets the source field on the offer URL string when the offer is consumed.
-// The Terraform provider leaves this field empty since it is does not support
-// cross-controller relations.
-//
-// Until that changes, we clean the URL to assist in scenarios where an offer URL
-// has the source field set.
+// The Terraform provider only sets it when the offer is hosted on another controller,
+// in which case the integration keeps the configured URL, so we clean the URL to compare
+// offer URLs regardless of the source field.
 func removeOfferURLSource(offerURL string) (string, error) {
 	url, err := crossmodel.ParseOfferURL(offerURL)
 	if err != nil {
@@ -8,7 +8,9 @@ import (
 
 	"github.com/juju/charm/v12"
 	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/rpc/params"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
 )
 
 func TestMatchByEndpoints(t *testing.T) {
@@ -135,3 +137,67 @@ func TestMatchByEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceControllerConfig(t *testing.T) {
+	// Without explicit details, the controller is read from the
+	// local Juju client store.
+	config := sourceControllerConfig("remote", nil)
+	assert.Equal(t, ControllerConfiguration{ControllerName: "remote"}, config)
+	assert.True(t, config.loginViaClientStore())
+
+	config = sourceControllerConfig("remote", &ControllerConfiguration{
+		ControllerAddresses: []string{"10.0.0.1:17070"},
+		Username:            "admin",
+		Password:            "secret",
+		CACert:              "cert",
+	})
+	assert.Equal(t, ControllerConfiguration{
+		ControllerAddresses: []string{"10.0.0.1:17070"},
+		Username:            "admin",
+		Password:            "secret",
+		CACert:              "cert",
+		ControllerName:      "remote",
+	}, config)
+	assert.False(t, config.loginViaClientStore())
+
+	config = sourceControllerConfig("remote", &ControllerConfiguration{
+		ControllerAddresses: []string{"10.0.0.1:17070"},
+		ClientID:            "client-id",
+		ClientSecret:        "client-secret",
+	})
+	assert.False(t, config.loginViaClientStore())
+}
+
+type OfferSuite struct {
+	suite.Suite
+	JujuSuite
+
+	testModelUUID string
+}
+
+func (s *OfferSuite) SetupSuite() {
+	s.testModelUUID = "fff19e46-8a0b-4a52-8e1d-7b16a40f8f2a"
+	s.testModelName = &s.testModelUUID
+}
+
+func (s *OfferSuite) TestListOffers() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockSharedClient.EXPECT().ModelOwnerAndName(s.testModelUUID).Return("admin", "db", nil)
+	s.mockSharedClient.EXPECT().ModelStatus(s.testModelUUID, s.mockConnection).Return(&params.FullStatus{
+		Offers: map[string]params.ApplicationOfferStatus{
+			"replicas": {ApplicationName: "mysql"},
+			"mysql":    {ApplicationName: "mysql"},
+		},
+	}, nil)
+	client := newOffersClient(s.mockSharedClient)
+
+	offerURLs, err := client.ListOffers(s.testModelUUID)
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"admin/db.mysql", "admin/db.replicas"}, offerURLs)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestOfferSuite(t *testing.T) {
+	suite.Run(t, new(OfferSuite))
+}
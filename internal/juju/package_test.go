@@ -3,5 +3,5 @@
 
 package juju_test
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package juju -destination mock_test.go github.com/juju/terraform-provider-juju/internal/juju SharedClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,ResourceAPIClient,SecretAPIClient,JaasAPIClient,KubernetesCloudAPIClient,CharmhubClient
+//go:generate go run go.uber.org/mock/mockgen -typed -package juju -destination mock_test.go github.com/juju/terraform-provider-juju/internal/juju SharedClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,LocalCharmAPIClient,MachineManagerAPIClient,ApplicationOffersAPIClient,AnnotationsAPIClient,ResourceAPIClient,SecretAPIClient,SecretBackendAPIClient,SpaceAPIClient,SubnetAPIClient,JaasAPIClient,KubernetesCloudAPIClient,CharmhubClient,ActionAPIClient
 //go:generate go run go.uber.org/mock/mockgen -typed -package juju -destination jujuapi_mock_test.go github.com/juju/juju/api Connection
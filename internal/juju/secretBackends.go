@@ -0,0 +1,175 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apisecretbackends "github.com/juju/juju/api/client/secretbackends"
+)
+
+type secretBackendsClient struct {
+	SharedClient
+
+	getSecretBackendAPIClient func(connection api.Connection) SecretBackendAPIClient
+}
+
+type CreateSecretBackendInput struct {
+	Name                string
+	BackendType         string
+	TokenRotateInterval *time.Duration
+	Config              map[string]interface{}
+}
+
+type CreateSecretBackendOutput struct {
+	ID string
+}
+
+type ReadSecretBackendInput struct {
+	Name string
+}
+
+type ReadSecretBackendOutput struct {
+	ID                  string
+	Name                string
+	BackendType         string
+	TokenRotateInterval *time.Duration
+	Config              map[string]interface{}
+	Status              string
+	Message             string
+}
+
+type UpdateSecretBackendInput struct {
+	Name                string
+	NameChange          *string
+	TokenRotateInterval *time.Duration
+	Config              map[string]interface{}
+	Reset               []string
+}
+
+type DeleteSecretBackendInput struct {
+	Name string
+}
+
+func newSecretBackendsClient(sc SharedClient) *secretBackendsClient {
+	return &secretBackendsClient{
+		SharedClient: sc,
+		getSecretBackendAPIClient: func(connection api.Connection) SecretBackendAPIClient {
+			return apisecretbackends.NewClient(connection)
+		},
+	}
+}
+
+// CreateSecretBackend adds a new secret backend to the controller.
+func (c *secretBackendsClient) CreateSecretBackend(input *CreateSecretBackendInput) (*CreateSecretBackendOutput, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := c.getSecretBackendAPIClient(conn)
+
+	err = client.AddSecretBackend(apisecretbackends.CreateSecretBackend{
+		Name:                input.Name,
+		BackendType:         input.BackendType,
+		TokenRotateInterval: input.TokenRotateInterval,
+		Config:              input.Config,
+	})
+	if err != nil {
+		return nil, errors.Annotatef(typedError(err), "adding secret backend %q", input.Name)
+	}
+
+	// The ID is generated by the controller, read the
+	// backend back to find it.
+	backend, err := c.readSecretBackend(client, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateSecretBackendOutput{ID: backend.ID}, nil
+}
+
+// ReadSecretBackend reads a secret backend, including its revealed config.
+func (c *secretBackendsClient) ReadSecretBackend(input *ReadSecretBackendInput) (*ReadSecretBackendOutput, error) {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	backend, err := c.readSecretBackend(c.getSecretBackendAPIClient(conn), input.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadSecretBackendOutput{
+		ID:                  backend.ID,
+		Name:                backend.Name,
+		BackendType:         backend.BackendType,
+		TokenRotateInterval: backend.TokenRotateInterval,
+		Config:              backend.Config,
+		Status:              string(backend.Status),
+		Message:             backend.Message,
+	}, nil
+}
+
+func (c *secretBackendsClient) readSecretBackend(client SecretBackendAPIClient, name string) (*apisecretbackends.SecretBackend, error) {
+	backends, err := client.ListSecretBackends([]string{name}, true)
+	if err != nil {
+		return nil, typedError(err)
+	}
+	for _, backend := range backends {
+		if backend.Name != name {
+			continue
+		}
+		if backend.Error != nil {
+			return nil, typedError(backend.Error)
+		}
+		return &backend, nil
+	}
+	return nil, errors.NotFoundf("secret backend %q", name)
+}
+
+// UpdateSecretBackend updates the name, config or token rotate interval
+// of a secret backend.
+func (c *secretBackendsClient) UpdateSecretBackend(input *UpdateSecretBackendInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := c.getSecretBackendAPIClient(conn)
+
+	err = client.UpdateSecretBackend(apisecretbackends.UpdateSecretBackend{
+		Name:                input.Name,
+		NameChange:          input.NameChange,
+		TokenRotateInterval: input.TokenRotateInterval,
+		Config:              input.Config,
+		Reset:               input.Reset,
+	}, false)
+	if err != nil {
+		return errors.Annotatef(typedError(err), "updating secret backend %q", input.Name)
+	}
+	return nil
+}
+
+// DeleteSecretBackend removes a secret backend from the controller.
+// The backend must not be in use by any model.
+func (c *secretBackendsClient) DeleteSecretBackend(input *DeleteSecretBackendInput) error {
+	conn, err := c.GetConnection(nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := c.getSecretBackendAPIClient(conn)
+
+	err = typedError(client.RemoveSecretBackend(input.Name, false))
+	if err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Annotatef(err, "removing secret backend %q", input.Name)
+	}
+	return nil
+}
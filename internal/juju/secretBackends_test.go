@@ -0,0 +1,150 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apisecretbackends "github.com/juju/juju/api/client/secretbackends"
+	"github.com/juju/juju/core/status"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type SecretBackendSuite struct {
+	suite.Suite
+	JujuSuite
+
+	mockSecretBackendClient *MockSecretBackendAPIClient
+}
+
+func (s *SecretBackendSuite) setupMocks(t *testing.T) *gomock.Controller {
+	ctlr := s.JujuSuite.setupMocks(t)
+	s.mockSecretBackendClient = NewMockSecretBackendAPIClient(ctlr)
+
+	return ctlr
+}
+
+func (s *SecretBackendSuite) getSecretBackendsClient() secretBackendsClient {
+	return secretBackendsClient{
+		SharedClient: s.JujuSuite.mockSharedClient,
+		getSecretBackendAPIClient: func(api.Connection) SecretBackendAPIClient {
+			return s.mockSecretBackendClient
+		},
+	}
+}
+
+func (s *SecretBackendSuite) TestCreateSecretBackend() {
+	defer s.setupMocks(s.T()).Finish()
+
+	interval := 48 * time.Hour
+	config := map[string]interface{}{
+		"endpoint": "http://vault.example.com:8200",
+		"token":    "s.token",
+	}
+	s.mockSecretBackendClient.EXPECT().AddSecretBackend(apisecretbackends.CreateSecretBackend{
+		Name:                "myvault",
+		BackendType:         "vault",
+		TokenRotateInterval: &interval,
+		Config:              config,
+	}).Return(nil)
+	s.mockSecretBackendClient.EXPECT().ListSecretBackends([]string{"myvault"}, true).Return(
+		[]apisecretbackends.SecretBackend{{
+			ID:          "backend-id",
+			Name:        "myvault",
+			BackendType: "vault",
+			Config:      config,
+		}}, nil)
+
+	client := s.getSecretBackendsClient()
+	output, err := client.CreateSecretBackend(&CreateSecretBackendInput{
+		Name:                "myvault",
+		BackendType:         "vault",
+		TokenRotateInterval: &interval,
+		Config:              config,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("backend-id", output.ID)
+}
+
+func (s *SecretBackendSuite) TestReadSecretBackend() {
+	defer s.setupMocks(s.T()).Finish()
+
+	interval := time.Hour
+	s.mockSecretBackendClient.EXPECT().ListSecretBackends([]string{"myvault"}, true).Return(
+		[]apisecretbackends.SecretBackend{{
+			ID:                  "backend-id",
+			Name:                "myvault",
+			BackendType:         "vault",
+			TokenRotateInterval: &interval,
+			Config:              map[string]interface{}{"endpoint": "http://vault.example.com:8200"},
+			Status:              status.Active,
+		}}, nil)
+
+	client := s.getSecretBackendsClient()
+	output, err := client.ReadSecretBackend(&ReadSecretBackendInput{Name: "myvault"})
+	s.Require().NoError(err)
+	s.Assert().Equal(&ReadSecretBackendOutput{
+		ID:                  "backend-id",
+		Name:                "myvault",
+		BackendType:         "vault",
+		TokenRotateInterval: &interval,
+		Config:              map[string]interface{}{"endpoint": "http://vault.example.com:8200"},
+		Status:              "active",
+	}, output)
+}
+
+func (s *SecretBackendSuite) TestReadSecretBackendNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockSecretBackendClient.EXPECT().ListSecretBackends([]string{"myvault"}, true).Return(nil, nil)
+
+	client := s.getSecretBackendsClient()
+	_, err := client.ReadSecretBackend(&ReadSecretBackendInput{Name: "myvault"})
+	s.Assert().True(errors.Is(err, errors.NotFound), err)
+}
+
+func (s *SecretBackendSuite) TestUpdateSecretBackend() {
+	defer s.setupMocks(s.T()).Finish()
+
+	newName := "newvault"
+	s.mockSecretBackendClient.EXPECT().UpdateSecretBackend(apisecretbackends.UpdateSecretBackend{
+		Name:       "myvault",
+		NameChange: &newName,
+		Config:     map[string]interface{}{"token": "s.newtoken"},
+		Reset:      []string{"namespace"},
+	}, false).Return(nil)
+
+	client := s.getSecretBackendsClient()
+	err := client.UpdateSecretBackend(&UpdateSecretBackendInput{
+		Name:       "myvault",
+		NameChange: &newName,
+		Config:     map[string]interface{}{"token": "s.newtoken"},
+		Reset:      []string{"namespace"},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *SecretBackendSuite) TestDeleteSecretBackend() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockSecretBackendClient.EXPECT().RemoveSecretBackend("myvault", false).Return(nil)
+	s.mockSecretBackendClient.EXPECT().RemoveSecretBackend("gone", false).Return(errors.NotFoundf("secret backend %q", "gone"))
+	s.mockSecretBackendClient.EXPECT().RemoveSecretBackend("inuse", false).Return(errors.New(`backend "inuse" still contains secret content`))
+
+	client := s.getSecretBackendsClient()
+	s.Require().NoError(client.DeleteSecretBackend(&DeleteSecretBackendInput{Name: "myvault"}))
+	s.Require().NoError(client.DeleteSecretBackend(&DeleteSecretBackendInput{Name: "gone"}))
+	err := client.DeleteSecretBackend(&DeleteSecretBackendInput{Name: "inuse"})
+	s.Assert().ErrorContains(err, `removing secret backend "inuse"`)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestSecretBackendSuite(t *testing.T) {
+	suite.Run(t, new(SecretBackendSuite))
+}
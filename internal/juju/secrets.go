@@ -7,7 +7,9 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	jujuerrors "github.com/juju/errors"
 	"github.com/juju/juju/api"
@@ -53,6 +55,7 @@ type CreateSecretInput struct {
 	Name      string
 	Value     map[string]string
 	Info      string
+	AutoPrune *bool
 }
 
 type CreateSecretOutput struct {
@@ -74,6 +77,17 @@ type ReadSecretOutput struct {
 	Value        map[string]string
 	Applications []string
 	Info         string
+	// Revision is the latest revision of the secret.
+	Revision int
+	// Revisions are the revisions of the secret which have
+	// not been pruned, in ascending order.
+	Revisions []int
+	// RotatePolicy is how often the secret is rotated, if set.
+	RotatePolicy string
+	// ExpireTime is when the latest revision expires, if set.
+	ExpireTime *time.Time
+	// AutoPrune is whether unused revisions are pruned automatically.
+	AutoPrune bool
 }
 
 type UpdateSecretInput struct {
@@ -142,6 +156,19 @@ func (c *secretsClient) CreateSecret(input *CreateSecretInput) (CreateSecretOutp
 	if err != nil {
 		return CreateSecretOutput{}, typedError(err)
 	}
+	// Secrets cannot be created with auto prune set, so
+	// it is set with an update of the new secret.
+	if input.AutoPrune != nil && *input.AutoPrune {
+		err = secretAPIClient.UpdateSecret(secretURI, "", input.AutoPrune, "", "", map[string]string{})
+		if err != nil {
+			// Remove the new secret, else it is not tracked by the
+			// provider and creating it again fails on its name.
+			if removeErr := secretAPIClient.RemoveSecret(secretURI, "", nil); removeErr != nil {
+				return CreateSecretOutput{}, fmt.Errorf("setting auto prune: %w, removing secret %q: %v", typedError(err), secretURI.ID, removeErr)
+			}
+			return CreateSecretOutput{}, typedError(err)
+		}
+	}
 	return CreateSecretOutput{
 		SecretId:  secretURI.ID,
 		SecretURI: secretURI.String(),
@@ -193,6 +220,12 @@ func (c *secretsClient) ReadSecret(input *ReadSecretInput) (ReadSecretOutput, er
 	// Get applications from Access info
 	applications := getApplicationsFromAccessInfo(results[0].Access)
 
+	revisions := make([]int, 0, len(results[0].Revisions))
+	for _, revision := range results[0].Revisions {
+		revisions = append(revisions, revision.Revision)
+	}
+	sort.Ints(revisions)
+
 	return ReadSecretOutput{
 		SecretId:     results[0].Metadata.URI.ID,
 		SecretURI:    results[0].Metadata.URI.String(),
@@ -200,6 +233,11 @@ func (c *secretsClient) ReadSecret(input *ReadSecretInput) (ReadSecretOutput, er
 		Value:        decodedValue,
 		Applications: applications,
 		Info:         results[0].Metadata.Description,
+		Revision:     results[0].Metadata.LatestRevision,
+		Revisions:    revisions,
+		RotatePolicy: string(results[0].Metadata.RotatePolicy),
+		ExpireTime:   results[0].Metadata.LatestExpireTime,
+		AutoPrune:    results[0].Metadata.AutoPrune,
 	}, nil
 }
 
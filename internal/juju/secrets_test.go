@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/juju/juju/api"
 	apisecrets "github.com/juju/juju/api/client/secrets"
@@ -95,6 +96,64 @@ func (s *SecretSuite) TestCreateSecretError() {
 	s.Assert().Equal(errBoom, err)
 }
 
+func (s *SecretSuite) TestCreateSecretWithAutoPrune() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	decodedValue := map[string]string{"key": "value"}
+	encodedValue := map[string]string{"key": base64.StdEncoding.EncodeToString([]byte("value"))}
+	autoPrune := true
+
+	secretId := "secret:9m4e2mr0ui3e8a215n4g"
+	secretURI, err := coresecrets.ParseURI(secretId)
+	s.Require().NoError(err)
+	s.mockSecretClient.EXPECT().CreateSecret(
+		"test-secret", "", encodedValue,
+	).Return(secretURI.ID, nil)
+	s.mockSecretClient.EXPECT().UpdateSecret(
+		secretURI, "", &autoPrune, "", "", map[string]string{},
+	).Return(nil)
+
+	client := s.getSecretsClient()
+	output, err := client.CreateSecret(&CreateSecretInput{
+		ModelUUID: *s.testModelName,
+		Name:      "test-secret",
+		Value:     decodedValue,
+		AutoPrune: &autoPrune,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(secretURI.ID, output.SecretId)
+}
+
+func (s *SecretSuite) TestCreateSecretWithAutoPruneError() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	encodedValue := map[string]string{"key": base64.StdEncoding.EncodeToString([]byte("value"))}
+	autoPrune := true
+
+	secretId := "secret:9m4e2mr0ui3e8a215n4g"
+	secretURI, err := coresecrets.ParseURI(secretId)
+	s.Require().NoError(err)
+	s.mockSecretClient.EXPECT().CreateSecret(
+		"test-secret", "", encodedValue,
+	).Return(secretURI.ID, nil)
+	errBoom := errors.New("boom")
+	s.mockSecretClient.EXPECT().UpdateSecret(
+		secretURI, "", &autoPrune, "", "", map[string]string{},
+	).Return(errBoom)
+	s.mockSecretClient.EXPECT().RemoveSecret(secretURI, "", nil).Return(nil)
+
+	client := s.getSecretsClient()
+	_, err = client.CreateSecret(&CreateSecretInput{
+		ModelUUID: *s.testModelName,
+		Name:      "test-secret",
+		Value:     map[string]string{"key": "value"},
+		AutoPrune: &autoPrune,
+	})
+	s.Assert().ErrorIs(err, errBoom)
+}
+
 func (s *SecretSuite) TestReadSecret() {
 	ctlr := s.setupMocks(s.T())
 	defer ctlr.Finish()
@@ -171,6 +230,50 @@ func (s *SecretSuite) TestReadSecretError() {
 	s.Assert().Equal(errBoom, err)
 }
 
+func (s *SecretSuite) TestReadSecretRevisions() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	secretId := "secret:9m4e2mr0ui3e8a215n4g"
+	secretURI, err := coresecrets.ParseURI(secretId)
+	s.Require().NoError(err)
+	expireTime := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	value := base64.StdEncoding.EncodeToString([]byte("value"))
+	s.mockSecretClient.EXPECT().ListSecrets(
+		true, coresecrets.Filter{URI: secretURI},
+	).Return([]apisecrets.SecretDetails{
+		{
+			Metadata: coresecrets.SecretMetadata{
+				URI:              secretURI,
+				Version:          1,
+				LatestRevision:   3,
+				RotatePolicy:     coresecrets.RotateQuarterly,
+				LatestExpireTime: &expireTime,
+				AutoPrune:        true,
+			},
+			Revisions: []coresecrets.SecretRevisionMetadata{
+				{Revision: 3},
+				{Revision: 1},
+			},
+			Value: coresecrets.NewSecretValue(map[string]string{"key": value}),
+		},
+	}, nil)
+
+	client := s.getSecretsClient()
+	output, err := client.ReadSecret(&ReadSecretInput{
+		SecretId:  secretId,
+		ModelUUID: *s.testModelName,
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(3, output.Revision)
+	s.Assert().Equal([]int{1, 3}, output.Revisions)
+	s.Assert().Equal("quarterly", output.RotatePolicy)
+	s.Assert().Equal(&expireTime, output.ExpireTime)
+	s.Assert().True(output.AutoPrune)
+}
+
 func (s *SecretSuite) TestUpdateSecretWithRenaming() {
 	ctlr := s.setupMocks(s.T())
 	defer ctlr.Finish()
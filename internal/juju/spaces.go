@@ -0,0 +1,281 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apispaces "github.com/juju/juju/api/client/spaces"
+	apisubnets "github.com/juju/juju/api/client/subnets"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
+)
+
+type spacesClient struct {
+	SharedClient
+
+	getSpaceAPIClient  func(api.Connection) SpaceAPIClient
+	getSubnetAPIClient func(api.Connection) SubnetAPIClient
+}
+
+type CreateSpaceInput struct {
+	ModelUUID string
+	Name      string
+	// CIDRs of existing subnets to move into the space.
+	CIDRs []string
+}
+
+type CreateSpaceOutput struct {
+	ID string
+}
+
+type ReadSpaceInput struct {
+	ModelUUID string
+	Name      string
+}
+
+type ReadSpaceOutput struct {
+	ID    string
+	Name  string
+	CIDRs []string
+}
+
+type RenameSpaceInput struct {
+	ModelUUID string
+	Name      string
+	NewName   string
+}
+
+type UpdateSpaceInput struct {
+	ModelUUID string
+	Name      string
+	// AddCIDRs are the CIDRs of subnets to move into the space.
+	AddCIDRs []string
+	// RemoveCIDRs are the CIDRs of subnets to move back to the
+	// default space.
+	RemoveCIDRs []string
+}
+
+type DeleteSpaceInput struct {
+	ModelUUID string
+	Name      string
+}
+
+type ListSubnetsInput struct {
+	ModelUUID string
+	// Space and Zone optionally filter the subnets listed.
+	Space string
+	Zone  string
+}
+
+// Subnet holds the details of a subnet known by a model.
+type Subnet struct {
+	CIDR              string
+	ProviderID        string
+	ProviderNetworkID string
+	ProviderSpaceID   string
+	VLANTag           int
+	Space             string
+	Zones             []string
+	Life              string
+}
+
+type ListSubnetsOutput struct {
+	Subnets []Subnet
+}
+
+func newSpacesClient(sc SharedClient) *spacesClient {
+	return &spacesClient{
+		SharedClient: sc,
+		getSpaceAPIClient: func(conn api.Connection) SpaceAPIClient {
+			return apispaces.NewAPI(conn)
+		},
+		getSubnetAPIClient: func(conn api.Connection) SubnetAPIClient {
+			return apisubnets.NewAPI(conn)
+		},
+	}
+}
+
+// CreateSpace creates a space in the model, moving the subnets with the
+// given CIDRs into it.
+func (c *spacesClient) CreateSpace(input *CreateSpaceInput) (*CreateSpaceOutput, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := c.getSpaceAPIClient(conn)
+
+	if err := client.CreateSpace(input.Name, input.CIDRs, true); err != nil {
+		return nil, errors.Annotatef(typedError(err), "creating space %q", input.Name)
+	}
+
+	// The ID is generated by the controller, read the
+	// space back to find it.
+	space, err := client.ShowSpace(input.Name)
+	if err != nil {
+		return nil, errors.Annotatef(typedError(err), "reading space %q", input.Name)
+	}
+	return &CreateSpaceOutput{ID: space.Space.Id}, nil
+}
+
+// ReadSpace reads a space and the CIDRs of its subnets.
+func (c *spacesClient) ReadSpace(input *ReadSpaceInput) (*ReadSpaceOutput, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	space, err := c.getSpaceAPIClient(conn).ShowSpace(input.Name)
+	if err != nil {
+		return nil, typedError(err)
+	}
+
+	cidrs := make([]string, 0, len(space.Space.Subnets))
+	for _, subnet := range space.Space.Subnets {
+		cidrs = append(cidrs, subnet.CIDR)
+	}
+	sort.Strings(cidrs)
+
+	return &ReadSpaceOutput{
+		ID:    space.Space.Id,
+		Name:  space.Space.Name,
+		CIDRs: cidrs,
+	}, nil
+}
+
+// RenameSpace renames a space.
+func (c *spacesClient) RenameSpace(input *RenameSpaceInput) error {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if err := c.getSpaceAPIClient(conn).RenameSpace(input.Name, input.NewName); err != nil {
+		return errors.Annotatef(typedError(err), "renaming space %q", input.Name)
+	}
+	return nil
+}
+
+// UpdateSpace moves subnets in and out of a space. Subnets removed
+// from the space are moved back to the default space.
+func (c *spacesClient) UpdateSpace(input *UpdateSpaceInput) error {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := c.getSpaceAPIClient(conn)
+	subnetClient := c.getSubnetAPIClient(conn)
+
+	if err := moveSubnets(client, subnetClient, input.Name, input.AddCIDRs); err != nil {
+		return err
+	}
+	return moveSubnets(client, subnetClient, network.AlphaSpaceName, input.RemoveCIDRs)
+}
+
+func moveSubnets(client SpaceAPIClient, subnetClient SubnetAPIClient, space string, cidrs []string) error {
+	if len(cidrs) == 0 {
+		return nil
+	}
+
+	// Subnets are moved by ID, look them up from their CIDRs.
+	results, err := subnetClient.SubnetsByCIDR(cidrs)
+	if err != nil {
+		return errors.Annotatef(typedError(err), "finding subnets %v", cidrs)
+	}
+	if len(results) != len(cidrs) {
+		return errors.Errorf("expected %d results finding subnets %v, got %d", len(cidrs), cidrs, len(results))
+	}
+	var tags []names.SubnetTag
+	for i, result := range results {
+		// Fail on any unknown CIDR, rather than moving the others and
+		// leaving the space different from its configuration.
+		if result.Error != nil {
+			return errors.Annotatef(typedError(result.Error), "finding subnet %q", cidrs[i])
+		}
+		if len(result.Subnets) == 0 {
+			return errors.NotFoundf("subnet %q", cidrs[i])
+		}
+		for _, subnet := range result.Subnets {
+			tags = append(tags, names.NewSubnetTag(subnet.ID))
+		}
+	}
+
+	if _, err := client.MoveSubnets(names.NewSpaceTag(space), tags, false); err != nil {
+		return errors.Annotatef(typedError(err), "moving subnets %v to space %q", cidrs, space)
+	}
+	return nil
+}
+
+// DeleteSpace removes a space. Its subnets are moved back to the
+// default space.
+func (c *spacesClient) DeleteSpace(input *DeleteSpaceInput) error {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	_, err = c.getSpaceAPIClient(conn).RemoveSpace(input.Name, false, false)
+	err = typedError(err)
+	if err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Annotatef(err, "removing space %q", input.Name)
+	}
+	return nil
+}
+
+// ListSubnets lists the subnets known by the model, optionally
+// filtered by space and availability zone.
+func (c *spacesClient) ListSubnets(input *ListSubnetsInput) (*ListSubnetsOutput, error) {
+	conn, err := c.GetConnection(&input.ModelUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	var spaceTag *names.SpaceTag
+	if input.Space != "" {
+		tag := names.NewSpaceTag(input.Space)
+		spaceTag = &tag
+	}
+
+	subnets, err := c.getSubnetAPIClient(conn).ListSubnets(spaceTag, input.Zone)
+	if err != nil {
+		return nil, typedError(err)
+	}
+
+	output := &ListSubnetsOutput{Subnets: make([]Subnet, 0, len(subnets))}
+	for _, subnet := range subnets {
+		output.Subnets = append(output.Subnets, subnetFromParams(subnet))
+	}
+	sort.Slice(output.Subnets, func(i, j int) bool {
+		return output.Subnets[i].CIDR < output.Subnets[j].CIDR
+	})
+	return output, nil
+}
+
+func subnetFromParams(subnet params.Subnet) Subnet {
+	var space string
+	if tag, err := names.ParseSpaceTag(subnet.SpaceTag); err == nil {
+		space = tag.Id()
+	}
+	return Subnet{
+		CIDR:              subnet.CIDR,
+		ProviderID:        subnet.ProviderId,
+		ProviderNetworkID: subnet.ProviderNetworkId,
+		ProviderSpaceID:   subnet.ProviderSpaceId,
+		VLANTag:           subnet.VLANTag,
+		Space:             space,
+		Zones:             subnet.Zones,
+		Life:              string(subnet.Life),
+	}
+}
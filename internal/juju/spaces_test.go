@@ -0,0 +1,232 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type SpaceSuite struct {
+	suite.Suite
+	JujuSuite
+
+	mockSpaceClient  *MockSpaceAPIClient
+	mockSubnetClient *MockSubnetAPIClient
+}
+
+func (s *SpaceSuite) SetupSuite() {
+	s.testModelName = strPtr("test-space-model")
+}
+
+func (s *SpaceSuite) setupMocks(t *testing.T) *gomock.Controller {
+	ctlr := s.JujuSuite.setupMocks(t)
+	s.mockSpaceClient = NewMockSpaceAPIClient(ctlr)
+	s.mockSubnetClient = NewMockSubnetAPIClient(ctlr)
+
+	return ctlr
+}
+
+func (s *SpaceSuite) getSpacesClient() spacesClient {
+	return spacesClient{
+		SharedClient: s.JujuSuite.mockSharedClient,
+		getSpaceAPIClient: func(api.Connection) SpaceAPIClient {
+			return s.mockSpaceClient
+		},
+		getSubnetAPIClient: func(api.Connection) SubnetAPIClient {
+			return s.mockSubnetClient
+		},
+	}
+}
+
+func (s *SpaceSuite) TestCreateSpace() {
+	defer s.setupMocks(s.T()).Finish()
+
+	cidrs := []string{"10.0.0.0/24"}
+	s.mockSpaceClient.EXPECT().CreateSpace("db", cidrs, true).Return(nil)
+	s.mockSpaceClient.EXPECT().ShowSpace("db").Return(params.ShowSpaceResult{
+		Space: params.Space{Id: "1", Name: "db"},
+	}, nil)
+
+	client := s.getSpacesClient()
+	output, err := client.CreateSpace(&CreateSpaceInput{
+		ModelUUID: *s.testModelName,
+		Name:      "db",
+		CIDRs:     cidrs,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("1", output.ID)
+}
+
+func (s *SpaceSuite) TestReadSpace() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockSpaceClient.EXPECT().ShowSpace("db").Return(params.ShowSpaceResult{
+		Space: params.Space{
+			Id:   "1",
+			Name: "db",
+			Subnets: []params.Subnet{
+				{CIDR: "10.0.1.0/24"},
+				{CIDR: "10.0.0.0/24"},
+			},
+		},
+	}, nil)
+
+	client := s.getSpacesClient()
+	output, err := client.ReadSpace(&ReadSpaceInput{
+		ModelUUID: *s.testModelName,
+		Name:      "db",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(&ReadSpaceOutput{
+		ID:    "1",
+		Name:  "db",
+		CIDRs: []string{"10.0.0.0/24", "10.0.1.0/24"},
+	}, output)
+}
+
+func (s *SpaceSuite) TestReadSpaceNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockSpaceClient.EXPECT().ShowSpace("db").Return(params.ShowSpaceResult{},
+		&params.Error{Code: params.CodeNotFound, Message: `space "db" not found`})
+
+	client := s.getSpacesClient()
+	_, err := client.ReadSpace(&ReadSpaceInput{
+		ModelUUID: *s.testModelName,
+		Name:      "db",
+	})
+	s.Require().Error(err)
+	s.Assert().True(errors.Is(err, errors.NotFound))
+}
+
+func (s *SpaceSuite) TestRenameSpace() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockSpaceClient.EXPECT().RenameSpace("db", "database").Return(nil)
+
+	client := s.getSpacesClient()
+	err := client.RenameSpace(&RenameSpaceInput{
+		ModelUUID: *s.testModelName,
+		Name:      "db",
+		NewName:   "database",
+	})
+	s.Require().NoError(err)
+}
+
+func (s *SpaceSuite) TestUpdateSpace() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockSubnetClient.EXPECT().SubnetsByCIDR([]string{"10.0.1.0/24"}).Return([]params.SubnetsResult{{
+		Subnets: []params.SubnetV2{{ID: "2", Subnet: params.Subnet{CIDR: "10.0.1.0/24"}}},
+	}}, nil)
+	s.mockSpaceClient.EXPECT().MoveSubnets(names.NewSpaceTag("database"), []names.SubnetTag{names.NewSubnetTag("2")}, false).
+		Return(params.MoveSubnetsResult{}, nil)
+	s.mockSubnetClient.EXPECT().SubnetsByCIDR([]string{"10.0.0.0/24"}).Return([]params.SubnetsResult{{
+		Subnets: []params.SubnetV2{{ID: "1", Subnet: params.Subnet{CIDR: "10.0.0.0/24"}}},
+	}}, nil)
+	s.mockSpaceClient.EXPECT().MoveSubnets(names.NewSpaceTag("alpha"), []names.SubnetTag{names.NewSubnetTag("1")}, false).
+		Return(params.MoveSubnetsResult{}, nil)
+
+	client := s.getSpacesClient()
+	err := client.UpdateSpace(&UpdateSpaceInput{
+		ModelUUID:   *s.testModelName,
+		Name:        "database",
+		AddCIDRs:    []string{"10.0.1.0/24"},
+		RemoveCIDRs: []string{"10.0.0.0/24"},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *SpaceSuite) TestUpdateSpaceUnknownCIDR() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockSubnetClient.EXPECT().SubnetsByCIDR([]string{"10.0.1.0/24", "10.0.2.0/24", "10.0.3.0/24"}).Return([]params.SubnetsResult{{
+		Subnets: []params.SubnetV2{{ID: "2", Subnet: params.Subnet{CIDR: "10.0.1.0/24"}}},
+	}, {
+		Error: &params.Error{Code: params.CodeNotFound, Message: `subnet "10.0.2.0/24" not found`},
+	}, {}}, nil)
+
+	client := s.getSpacesClient()
+	err := client.UpdateSpace(&UpdateSpaceInput{
+		ModelUUID: *s.testModelName,
+		Name:      "db",
+		AddCIDRs:  []string{"10.0.1.0/24", "10.0.2.0/24", "10.0.3.0/24"},
+	})
+	s.Require().Error(err)
+	s.Assert().True(errors.Is(err, errors.NotFound))
+	s.Assert().ErrorContains(err, `finding subnet "10.0.2.0/24"`)
+
+	s.mockSubnetClient.EXPECT().SubnetsByCIDR([]string{"10.0.1.0/24", "10.0.3.0/24"}).Return([]params.SubnetsResult{{
+		Subnets: []params.SubnetV2{{ID: "2", Subnet: params.Subnet{CIDR: "10.0.1.0/24"}}},
+	}, {}}, nil)
+
+	err = client.UpdateSpace(&UpdateSpaceInput{
+		ModelUUID: *s.testModelName,
+		Name:      "db",
+		AddCIDRs:  []string{"10.0.1.0/24", "10.0.3.0/24"},
+	})
+	s.Require().Error(err)
+	s.Assert().True(errors.Is(err, errors.NotFound))
+	s.Assert().ErrorContains(err, `subnet "10.0.3.0/24" not found`)
+}
+
+func (s *SpaceSuite) TestDeleteSpaceNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockSpaceClient.EXPECT().RemoveSpace("db", false, false).Return(params.RemoveSpaceResult{},
+		&params.Error{Code: params.CodeNotFound, Message: `space "db" not found`})
+
+	client := s.getSpacesClient()
+	err := client.DeleteSpace(&DeleteSpaceInput{
+		ModelUUID: *s.testModelName,
+		Name:      "db",
+	})
+	s.Require().NoError(err)
+}
+
+func (s *SpaceSuite) TestListSubnets() {
+	defer s.setupMocks(s.T()).Finish()
+
+	spaceTag := names.NewSpaceTag("db")
+	s.mockSubnetClient.EXPECT().ListSubnets(&spaceTag, "").Return([]params.Subnet{{
+		CIDR:     "10.0.1.0/24",
+		SpaceTag: "space-db",
+		VLANTag:  42,
+		Zones:    []string{"zone1"},
+		Life:     "alive",
+	}, {
+		CIDR:     "10.0.0.0/24",
+		SpaceTag: "space-db",
+		Zones:    []string{"zone1"},
+		Life:     "alive",
+	}}, nil)
+
+	client := s.getSpacesClient()
+	output, err := client.ListSubnets(&ListSubnetsInput{
+		ModelUUID: *s.testModelName,
+		Space:     "db",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(output.Subnets, 2)
+	s.Assert().Equal(Subnet{
+		CIDR:  "10.0.0.0/24",
+		Space: "db",
+		Zones: []string{"zone1"},
+		Life:  "alive",
+	}, output.Subnets[0])
+	s.Assert().Equal(42, output.Subnets[1].VLANTag)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestSpaceSuite(t *testing.T) {
+	suite.Run(t, new(SpaceSuite))
+}
@@ -5,111 +5,65 @@ package juju
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-	"os/exec"
-	"strings"
-	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jujuerrors "github.com/juju/errors"
 	apiapplication "github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/jujuclient"
 	"github.com/juju/names/v5"
 )
 
-// controllerConfig is a representation of the output
-// returned when running the CLI command
-// `juju show-controller --show-password`
-type controllerConfig struct {
-	ProviderDetails struct {
-		UUID                   string   `json:"uuid"`
-		ApiEndpoints           []string `json:"api-endpoints"`
-		Cloud                  string   `json:"cloud"`
-		Region                 string   `json:"region"`
-		AgentVersion           string   `json:"agent-version"`
-		AgentGitCommit         string   `json:"agent-git-commit"`
-		ControllerModelVersion string   `json:"controller-model-version"`
-		MongoVersion           string   `json:"mongo-version"`
-		CAFingerprint          string   `json:"ca-fingerprint"`
-		CACert                 string   `json:"ca-cert"`
-	} `json:"details"`
-	CurrentModel string `json:"current-model"`
-	Models       map[string]struct {
-		UUID      string `json:"uuid"`
-		UnitCount uint   `json:"unit-count"`
-	} `json:"models"`
-	Account struct {
-		User     string `json:"user"`
-		Password string `json:"password"`
-		Access   string `json:"access"`
-	} `json:"account"`
+// LocalControllerConfig holds the details of a controller, and of the
+// account used to log in to it, as stored by the Juju client in
+// controllers.yaml and accounts.yaml under JUJU_DATA.
+type LocalControllerConfig struct {
+	ControllerName      string
+	ControllerAddresses []string
+	CACert              string
+	Username            string
+	// Password is empty for accounts which log in with macaroons,
+	// e.g. after `juju login` against an external identity provider.
+	Password string
 }
 
-// localProviderConfig is populated once and queried later
-// to avoid multiple juju CLI executions
-var localProviderConfig map[string]string
-
-// singleQuery will be used to limit the number of CLI queries to ONE
-var singleQuery sync.Once
-
-// GetLocalControllerConfig runs the locally installed juju command,
-// if available, to get the current controller configuration.
-func GetLocalControllerConfig() (map[string]string, bool) {
-	// populate the controller controllerConfig information only once
-	singleQuery.Do(populateControllerConfig)
-
-	return localProviderConfig, localProviderConfig == nil
+// GetLocalControllerConfig reads the details of the named controller
+// from the local Juju client store. If the controller name is empty,
+// the current controller of the Juju client is used.
+func GetLocalControllerConfig(controllerName string) (*LocalControllerConfig, error) {
+	return getLocalControllerConfig(jujuclient.NewFileClientStore(), controllerName)
 }
 
-// populateControllerConfig executes the local juju CLI command
-// to obtain the current controller configuration
-func populateControllerConfig() {
-	// get the value from the juju provider
-	cmd := exec.Command("juju", "show-controller", "--show-password", "--format=json")
-
-	cmdData, err := cmd.Output()
-	if err != nil {
-		tflog.Error(context.TODO(), "error invoking juju CLI", map[string]interface{}{"error": err})
-		return
+func getLocalControllerConfig(store jujuclient.ClientStore, controllerName string) (*LocalControllerConfig, error) {
+	if controllerName == "" {
+		var err error
+		controllerName, err = store.CurrentController()
+		if err != nil {
+			return nil, jujuerrors.Annotate(err, "finding the current controller")
+		}
 	}
-
-	// given that the CLI output is a map containing arbitrary keys
-	// (controllers) and fixed json structures, we have to do some
-	// workaround to populate the struct
-	var cliOutput interface{}
-	err = json.Unmarshal(cmdData, &cliOutput)
+	controller, err := store.ControllerByName(controllerName)
 	if err != nil {
-		tflog.Error(context.TODO(), "error unmarshalling Juju CLI output", map[string]interface{}{"error": err})
-		return
+		return nil, jujuerrors.Annotatef(err, "reading controller %q", controllerName)
 	}
-
-	// convert to the map and extract the only entry
-	controllerConfig := controllerConfig{}
-	for _, v := range cliOutput.(map[string]interface{}) {
-		// now v is a map[string]interface{} type
-		marshalled, err := json.Marshal(v)
-		if err != nil {
-			tflog.Error(context.TODO(), "error marshalling provider controllerConfig", map[string]interface{}{"error": err})
-			return
-		}
-		// now we have a controllerConfig type
-		err = json.Unmarshal(marshalled, &controllerConfig)
-		if err != nil {
-			tflog.Error(context.TODO(), "error unmarshalling provider configuration from Juju CLI", map[string]interface{}{"error": err})
-			return
-		}
-		break
+	account, err := store.AccountDetails(controllerName)
+	if err != nil {
+		return nil, jujuerrors.Annotatef(err, "reading account of controller %q", controllerName)
 	}
 
-	localProviderConfig = map[string]string{}
-	localProviderConfig["JUJU_AGENT_VERSION"] = controllerConfig.ProviderDetails.AgentVersion
-	localProviderConfig["JUJU_CONTROLLER_ADDRESSES"] = strings.Join(controllerConfig.ProviderDetails.ApiEndpoints, ",")
-	localProviderConfig["JUJU_CA_CERT"] = controllerConfig.ProviderDetails.CACert
-	localProviderConfig["JUJU_USERNAME"] = controllerConfig.Account.User
-	localProviderConfig["JUJU_PASSWORD"] = controllerConfig.Account.Password
-
-	tflog.Debug(context.TODO(), "local provider controllerConfig was set", map[string]interface{}{"localProviderConfig": fmt.Sprintf("%#v", localProviderConfig)})
+	tflog.Debug(context.TODO(), "local controller config was read", map[string]interface{}{
+		"controller": controllerName,
+		"addresses":  controller.APIEndpoints,
+		"user":       account.User,
+	})
+	return &LocalControllerConfig{
+		ControllerName:      controllerName,
+		ControllerAddresses: controller.APIEndpoints,
+		CACert:              controller.CACert,
+		Username:            account.User,
+		Password:            account.Password,
+	}, nil
 }
 
 // WaitForAppAvailable blocks the execution flow and waits until all the
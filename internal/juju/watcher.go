@@ -0,0 +1,310 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	apiclient "github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	// watcherIdleTimeout is how long a model watcher keeps running
+	// after the last time someone waited on the changes of its model.
+	watcherIdleTimeout = 5 * time.Minute
+	// watcherRestartDelay is how long to wait before starting a new
+	// watcher for a model after the previous one failed. Meanwhile,
+	// callers fall back to polling the model status.
+	watcherRestartDelay = time.Minute
+)
+
+// errWatcherIdle is the reason a watcher stopped when it was no longer
+// being used.
+var errWatcherIdle = errors.ConstError("watcher idle")
+
+// ModelSnapshot holds the entities of a model as last reported by the
+// AllWatcher. The entities are shared with the watcher and must not be
+// modified.
+type ModelSnapshot struct {
+	Applications map[string]*params.ApplicationInfo
+	Units        map[string]*params.UnitInfo
+	Machines     map[string]*params.MachineInfo
+	Relations    map[string]*params.RelationInfo
+}
+
+// modelWatcher keeps an incrementally updated snapshot of a model
+// from the AllWatcher and notifies waiters of every change.
+type modelWatcher struct {
+	modelUUID string
+
+	mu         sync.Mutex
+	entities   map[params.EntityId]params.EntityInfo
+	generation uint64
+	// changed is closed and replaced every time deltas are received.
+	changed   chan struct{}
+	ready     bool
+	stopped   bool
+	stoppedAt time.Time
+	err       error
+	lastUsed  time.Time
+
+	// The full status is fetched at most once per generation, instead
+	// of every time the status cache expires.
+	status           *params.FullStatus
+	statusGeneration uint64
+	statusFetch      *statusFetch
+}
+
+// statusFetch is an in-flight fetch of the model status, which
+// concurrent callers wait for rather than fetching it again.
+type statusFetch struct {
+	done   chan struct{}
+	status *params.FullStatus
+	err    error
+}
+
+func newModelWatcher(modelUUID string) *modelWatcher {
+	return &modelWatcher{
+		modelUUID: modelUUID,
+		entities:  make(map[params.EntityId]params.EntityInfo),
+		changed:   make(chan struct{}),
+		lastUsed:  time.Now(),
+	}
+}
+
+// run watches the model over conn until the watcher fails or is no
+// longer used. It takes ownership of the connection.
+func (w *modelWatcher) run(conn api.Connection, logger *jujuLoggerShim) error {
+	defer func() { _ = conn.Close() }()
+
+	allWatcher, err := apiclient.NewClient(conn, logger).WatchAll()
+	if err != nil {
+		return errors.Annotatef(err, "watching model %q", w.modelUUID)
+	}
+
+	idle := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(watcherIdleTimeout / 5)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if w.idleSince() > watcherIdleTimeout {
+					close(idle)
+					_ = allWatcher.Stop()
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		deltas, err := allWatcher.Next()
+		if err != nil {
+			select {
+			case <-idle:
+				return errWatcherIdle
+			default:
+			}
+			_ = allWatcher.Stop()
+			return errors.Annotatef(err, "watching model %q", w.modelUUID)
+		}
+		w.apply(deltas)
+	}
+}
+
+// apply updates the snapshot with the deltas and wakes up the waiters.
+func (w *modelWatcher) apply(deltas []params.Delta) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, delta := range deltas {
+		id := delta.Entity.EntityId()
+		if delta.Removed {
+			delete(w.entities, id)
+		} else {
+			w.entities[id] = delta.Entity
+		}
+	}
+	w.ready = true
+	w.generation++
+	close(w.changed)
+	w.changed = make(chan struct{})
+}
+
+// stop marks the watcher as stopped with the given error and wakes up
+// the waiters, which fall back to polling.
+func (w *modelWatcher) stop(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.stopped = true
+	w.stoppedAt = time.Now()
+	w.err = err
+	close(w.changed)
+}
+
+// running returns whether the watcher received the initial state of the
+// model and is still watching it.
+func (w *modelWatcher) running() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.ready && !w.stopped
+}
+
+// canRestart returns whether a new watcher may replace this one.
+func (w *modelWatcher) canRestart() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.stopped {
+		return false
+	}
+	return errors.Is(w.err, errWatcherIdle) || time.Since(w.stoppedAt) > watcherRestartDelay
+}
+
+func (w *modelWatcher) idleSince() time.Duration {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return time.Since(w.lastUsed)
+}
+
+// changes returns a channel which is closed on the next change of the
+// model. A nil channel is returned once the watcher stopped.
+func (w *modelWatcher) changes() <-chan struct{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.lastUsed = time.Now()
+	if w.stopped {
+		return nil
+	}
+	return w.changed
+}
+
+// snapshot returns the current entities of the model.
+func (w *modelWatcher) snapshot() *ModelSnapshot {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	snapshot := &ModelSnapshot{
+		Applications: make(map[string]*params.ApplicationInfo),
+		Units:        make(map[string]*params.UnitInfo),
+		Machines:     make(map[string]*params.MachineInfo),
+		Relations:    make(map[string]*params.RelationInfo),
+	}
+	for id, entity := range w.entities {
+		switch info := entity.(type) {
+		case *params.ApplicationInfo:
+			snapshot.Applications[id.Id] = info
+		case *params.UnitInfo:
+			snapshot.Units[id.Id] = info
+		case *params.MachineInfo:
+			snapshot.Machines[id.Id] = info
+		case *params.RelationInfo:
+			snapshot.Relations[id.Id] = info
+		}
+	}
+	return snapshot
+}
+
+// modelStatus returns the full status of the model, using fetch only if
+// the model changed since the status was last fetched.
+func (w *modelWatcher) modelStatus(fetch func() (*params.FullStatus, error)) (*params.FullStatus, error) {
+	w.mu.Lock()
+	if w.status != nil && w.statusGeneration == w.generation {
+		status := w.status
+		w.mu.Unlock()
+		return status, nil
+	}
+	if f := w.statusFetch; f != nil {
+		w.mu.Unlock()
+		<-f.done
+		return f.status, f.err
+	}
+	f := &statusFetch{done: make(chan struct{})}
+	w.statusFetch = f
+	generation := w.generation
+	w.mu.Unlock()
+
+	f.status, f.err = fetch()
+
+	w.mu.Lock()
+	w.statusFetch = nil
+	if f.err == nil {
+		w.status = f.status
+		w.statusGeneration = generation
+	}
+	w.mu.Unlock()
+	close(f.done)
+
+	return f.status, f.err
+}
+
+// modelWatcher returns the watcher of the model with the given UUID,
+// starting one if the model is not being watched yet.
+func (sc *sharedClient) modelWatcher(modelUUID string) *modelWatcher {
+	sc.watchersMu.Lock()
+	defer sc.watchersMu.Unlock()
+
+	if w, ok := sc.watchers[modelUUID]; ok && !w.canRestart() {
+		return w
+	}
+	w := newModelWatcher(modelUUID)
+	sc.watchers[modelUUID] = w
+	go func() {
+		conn, err := sc.dial(modelUUID)
+		if err == nil {
+			err = w.run(conn, sc.JujuLogger())
+		}
+		if errors.Is(err, errWatcherIdle) {
+			sc.Tracef("stopped idle watcher", map[string]interface{}{"model": modelUUID})
+		} else {
+			sc.Warnf("watcher stopped, falling back to polling the model status", map[string]interface{}{
+				"model": modelUUID,
+				"error": err,
+			})
+		}
+		w.stop(err)
+	}()
+	return w
+}
+
+// runningModelWatcher returns the watcher of the model with the given
+// UUID if it is running, without starting one.
+func (sc *sharedClient) runningModelWatcher(modelUUID string) (*modelWatcher, bool) {
+	sc.watchersMu.Lock()
+	w, ok := sc.watchers[modelUUID]
+	sc.watchersMu.Unlock()
+	if !ok || !w.running() {
+		return nil, false
+	}
+	return w, true
+}
+
+// ModelChanged returns a channel which is closed the next time the
+// model with the given UUID changes, starting to watch the model if
+// needed. A nil channel is returned if the model cannot be watched,
+// in which case callers should poll for changes instead.
+func (sc *sharedClient) ModelChanged(modelUUID string) <-chan struct{} {
+	return sc.modelWatcher(modelUUID).changes()
+}
+
+// ModelSnapshot returns the entities of the model with the given UUID
+// as last reported by its watcher. It returns false if the model is not
+// being watched.
+func (sc *sharedClient) ModelSnapshot(modelUUID string) (*ModelSnapshot, bool) {
+	w, ok := sc.runningModelWatcher(modelUUID)
+	if !ok {
+		return nil, false
+	}
+	return w.snapshot(), true
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModelWatcherSuite struct {
+	suite.Suite
+}
+
+func (s *ModelWatcherSuite) TestApplyUpdatesSnapshot() {
+	w := newModelWatcher("model-uuid")
+	s.Assert().False(w.running())
+
+	w.apply([]params.Delta{
+		{Entity: &params.ApplicationInfo{ModelUUID: "model-uuid", Name: "app"}},
+		{Entity: &params.UnitInfo{ModelUUID: "model-uuid", Name: "app/0", Application: "app"}},
+		{Entity: &params.UnitInfo{ModelUUID: "model-uuid", Name: "app/1", Application: "app"}},
+		{Entity: &params.MachineInfo{ModelUUID: "model-uuid", Id: "0"}},
+	})
+	s.Assert().True(w.running())
+
+	w.apply([]params.Delta{
+		{Entity: &params.UnitInfo{ModelUUID: "model-uuid", Name: "app/0", Application: "app",
+			WorkloadStatus: params.StatusInfo{Current: "active"}}},
+		{Removed: true, Entity: &params.UnitInfo{ModelUUID: "model-uuid", Name: "app/1"}},
+	})
+
+	snapshot := w.snapshot()
+	s.Assert().Len(snapshot.Applications, 1)
+	s.Assert().Len(snapshot.Machines, 1)
+	s.Require().Len(snapshot.Units, 1)
+	s.Assert().Equal("active", snapshot.Units["app/0"].WorkloadStatus.Current.String())
+}
+
+func (s *ModelWatcherSuite) TestChangesClosedOnApply() {
+	w := newModelWatcher("model-uuid")
+	changes := w.changes()
+
+	select {
+	case <-changes:
+		s.Fail("changes closed before any delta")
+	default:
+	}
+	w.apply([]params.Delta{{Entity: &params.MachineInfo{ModelUUID: "model-uuid", Id: "0"}}})
+	select {
+	case <-changes:
+	default:
+		s.Fail("changes not closed after delta")
+	}
+
+	s.Assert().NotEqual(changes, w.changes())
+}
+
+func (s *ModelWatcherSuite) TestStopFallsBackToPolling() {
+	w := newModelWatcher("model-uuid")
+	w.apply(nil)
+	changes := w.changes()
+
+	w.stop(errors.New("boom"))
+	select {
+	case <-changes:
+	default:
+		s.Fail("changes not closed after stop")
+	}
+	s.Assert().Nil(w.changes())
+	s.Assert().False(w.running())
+	s.Assert().False(w.canRestart())
+
+	w = newModelWatcher("model-uuid")
+	w.stop(errWatcherIdle)
+	s.Assert().True(w.canRestart())
+}
+
+func (s *ModelWatcherSuite) TestModelStatusFetchedOncePerChange() {
+	w := newModelWatcher("model-uuid")
+	w.apply(nil)
+
+	var fetches atomic.Int32
+	fetch := func() (*params.FullStatus, error) {
+		fetches.Add(1)
+		return &params.FullStatus{Model: params.ModelStatusInfo{Name: "model"}}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, err := w.modelStatus(fetch)
+			s.Assert().NoError(err)
+			s.Assert().Equal("model", status.Model.Name)
+		}()
+	}
+	wg.Wait()
+	s.Assert().LessOrEqual(fetches.Load(), int32(10))
+
+	before := fetches.Load()
+	_, err := w.modelStatus(fetch)
+	s.Require().NoError(err)
+	s.Assert().Equal(before, fetches.Load())
+
+	w.apply([]params.Delta{{Entity: &params.MachineInfo{ModelUUID: "model-uuid", Id: "0"}}})
+	_, err = w.modelStatus(fetch)
+	s.Require().NoError(err)
+	s.Assert().Equal(before+1, fetches.Load())
+}
+
+func (s *ModelWatcherSuite) TestModelStatusErrorNotCached() {
+	w := newModelWatcher("model-uuid")
+	w.apply(nil)
+
+	_, err := w.modelStatus(func() (*params.FullStatus, error) {
+		return nil, errors.New("boom")
+	})
+	s.Require().ErrorContains(err, "boom")
+
+	status, err := w.modelStatus(func() (*params.FullStatus, error) {
+		return &params.FullStatus{}, nil
+	})
+	s.Require().NoError(err)
+	s.Assert().NotNil(status)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestModelWatcherSuite(t *testing.T) {
+	suite.Run(t, new(ModelWatcherSuite))
+}
@@ -0,0 +1,292 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/charm/v12"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &modelBundleDataSource{}
+
+// NewModelBundleDataSource returns a new instance of the model bundle
+// data source.
+func NewModelBundleDataSource() datasource.DataSource {
+	return &modelBundleDataSource{}
+}
+
+type modelBundleDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// modelBundleDataSourceModel is the juju data stored by terraform.
+// tfsdk must match model bundle data source schema attribute names.
+type modelBundleDataSourceModel struct {
+	ModelUUID              types.String `tfsdk:"model_uuid"`
+	IncludeCharmDefaults   types.Bool   `tfsdk:"include_charm_defaults"`
+	StripMachinePlacements types.Bool   `tfsdk:"strip_machine_placements"`
+	Bundle                 types.String `tfsdk:"bundle"`
+	Applications           types.Map    `tfsdk:"applications"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedBundleApplication represents a single element of the
+// applications map.
+type nestedBundleApplication struct {
+	Charm       types.String `tfsdk:"charm"`
+	Channel     types.String `tfsdk:"channel"`
+	Revision    types.Int64  `tfsdk:"revision"`
+	Base        types.String `tfsdk:"base"`
+	Units       types.Int64  `tfsdk:"units"`
+	To          types.List   `tfsdk:"to"`
+	Constraints types.String `tfsdk:"constraints"`
+	Options     types.Map    `tfsdk:"options"`
+	Bindings    types.Map    `tfsdk:"bindings"`
+	Expose      types.Bool   `tfsdk:"expose"`
+	Trust       types.Bool   `tfsdk:"trust"`
+}
+
+// Metadata returns the full data source name as used in terraform plans.
+func (d *modelBundleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_bundle"
+}
+
+// Schema returns the schema for the model bundle data source.
+func (d *modelBundleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source exporting a model as a bundle, as `juju export-bundle` does.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The uuid of the model to export.",
+				Required:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+			},
+			"include_charm_defaults": schema.BoolAttribute{
+				Description: "Whether to include the default values of the charm config in the options of the applications. Defaults to false.",
+				Optional:    true,
+			},
+			"strip_machine_placements": schema.BoolAttribute{
+				Description: "Whether to remove the machines of the bundle and the placement directives of its applications. Defaults to false.",
+				Optional:    true,
+			},
+			"bundle": schema.StringAttribute{
+				Description: "The YAML of the bundle.",
+				Computed:    true,
+			},
+			"applications": schema.MapNestedAttribute{
+				Description: "The applications of the bundle, by name. Overlays of the bundle, e.g. the offers, are only found in the YAML.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"charm": schema.StringAttribute{
+							Description: "The name of the charm.",
+							Computed:    true,
+						},
+						"channel": schema.StringAttribute{
+							Description: "The channel of the charm.",
+							Computed:    true,
+						},
+						"revision": schema.Int64Attribute{
+							Description: "The revision of the charm.",
+							Computed:    true,
+						},
+						"base": schema.StringAttribute{
+							Description: "The base of the application.",
+							Computed:    true,
+						},
+						"units": schema.Int64Attribute{
+							Description: "The number of units of the application, or its scale on Kubernetes.",
+							Computed:    true,
+						},
+						"to": schema.ListAttribute{
+							Description: "The placement directives of the units.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"constraints": schema.StringAttribute{
+							Description: "The constraints of the application.",
+							Computed:    true,
+						},
+						"options": schema.MapAttribute{
+							Description: "The config of the application.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"bindings": schema.MapAttribute{
+							Description: "The spaces the endpoints of the application are bound to. The empty key is the default space of the application.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"expose": schema.BoolAttribute{
+							Description: "Whether the application is exposed.",
+							Computed:    true,
+						},
+						"trust": schema.BoolAttribute{
+							Description: "Whether the application is trusted.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *modelBundleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = provider.Client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceModelBundle)
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *modelBundleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "model bundle")
+		return
+	}
+
+	var data modelBundleDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := d.client.Models.ExportModelBundle(juju.ExportModelBundleInput{
+		ModelUUID:              data.ModelUUID.ValueString(),
+		IncludeCharmDefaults:   data.IncludeCharmDefaults.ValueBool(),
+		StripMachinePlacements: data.StripMachinePlacements.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export model %q as a bundle, got error: %s", data.ModelUUID.ValueString(), err))
+		return
+	}
+	d.trace(fmt.Sprintf("exported model %q as a bundle", data.ModelUUID.ValueString()))
+
+	applications := make(map[string]nestedBundleApplication, len(output.Applications))
+	for name, spec := range output.Applications {
+		application, diags := newNestedBundleApplication(ctx, spec)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		applications[name] = application
+	}
+	applicationType := req.Config.Schema.GetAttributes()["applications"].(schema.MapNestedAttribute).NestedObject.Type()
+	applicationsValue, diags := types.MapValueFrom(ctx, applicationType, applications)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Bundle = types.StringValue(output.Bundle)
+	data.Applications = applicationsValue
+	data.ID = data.ModelUUID
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newNestedBundleApplication converts an application of a bundle into its
+// Terraform representation. Options are converted to strings, as done for
+// the config of juju_application.
+func newNestedBundleApplication(ctx context.Context, spec *charm.ApplicationSpec) (nestedBundleApplication, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	revision := types.Int64Null()
+	if spec.Revision != nil {
+		revision = types.Int64Value(int64(*spec.Revision))
+	}
+	units := spec.NumUnits
+	if spec.Scale_ > 0 {
+		units = spec.Scale_
+	}
+	options := make(map[string]string, len(spec.Options))
+	for key, value := range spec.Options {
+		if str, ok := value.(string); ok {
+			options[key] = str
+		} else {
+			options[key] = fmt.Sprint(value)
+		}
+	}
+
+	to, dErr := types.ListValueFrom(ctx, types.StringType, nonNilStrings(spec.To))
+	diags.Append(dErr...)
+	optionsValue, dErr := types.MapValueFrom(ctx, types.StringType, options)
+	diags.Append(dErr...)
+	bindings, dErr := types.MapValueFrom(ctx, types.StringType, nonNilStringMap(spec.EndpointBindings))
+	diags.Append(dErr...)
+
+	return nestedBundleApplication{
+		Charm:       types.StringValue(spec.Charm),
+		Channel:     types.StringValue(spec.Channel),
+		Revision:    revision,
+		Base:        types.StringValue(spec.Base),
+		Units:       types.Int64Value(int64(units)),
+		To:          to,
+		Constraints: types.StringValue(spec.Constraints),
+		Options:     optionsValue,
+		Bindings:    bindings,
+		Expose:      types.BoolValue(spec.Expose),
+		Trust:       types.BoolValue(spec.RequiresTrust),
+	}, diags
+}
+
+// nonNilStringMap returns an empty map instead of nil, so that empty maps
+// are not stored as null.
+func nonNilStringMap(values map[string]string) map[string]string {
+	if values == nil {
+		return map[string]string{}
+	}
+	return values
+}
+
+func (d *modelBundleDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceModelBundle, msg, additionalFields...)
+}
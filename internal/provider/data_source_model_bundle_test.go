@@ -0,0 +1,110 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/juju/charm/v12"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNestedBundleApplication(t *testing.T) {
+	revision := 24
+	application, diags := newNestedBundleApplication(context.Background(), &charm.ApplicationSpec{
+		Charm:    "ubuntu",
+		Channel:  "latest/stable",
+		Revision: &revision,
+		Base:     "ubuntu@22.04/stable",
+		NumUnits: 2,
+		To:       []string{"0", "1"},
+		Options: map[string]interface{}{
+			"hostname": "ubuntu",
+			"port":     8080,
+			"debug":    true,
+		},
+		Expose: true,
+	})
+	require.False(t, diags.HasError(), diags)
+
+	options := map[string]string{}
+	require.False(t, application.Options.ElementsAs(context.Background(), &options, false).HasError())
+	assert.Equal(t, map[string]string{"hostname": "ubuntu", "port": "8080", "debug": "true"}, options)
+	assert.Equal(t, types.Int64Value(24), application.Revision)
+	assert.Equal(t, types.Int64Value(2), application.Units)
+	assert.Len(t, application.To.Elements(), 2)
+	assert.Empty(t, application.Bindings.Elements())
+	assert.True(t, application.Expose.ValueBool())
+	assert.False(t, application.Trust.ValueBool())
+
+	application, diags = newNestedBundleApplication(context.Background(), &charm.ApplicationSpec{
+		Charm:  "coredns",
+		Scale_: 3,
+	})
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, application.Revision.IsNull())
+	assert.Equal(t, types.Int64Value(3), application.Units)
+	assert.Empty(t, application.To.Elements())
+}
+
+func TestAcc_DataSourceModelBundle(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-model-bundle-test-model")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceModelBundle(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.juju_model_bundle.this", "id", "juju_model.this", "uuid"),
+					resource.TestMatchResourceAttr("data.juju_model_bundle.this", "bundle", regexp.MustCompile(`(?m)^machines:`)),
+					resource.TestCheckResourceAttr("data.juju_model_bundle.this", "applications.ubuntu.charm", "ubuntu"),
+					resource.TestCheckResourceAttr("data.juju_model_bundle.this", "applications.ubuntu.units", "1"),
+					resource.TestCheckResourceAttr("data.juju_model_bundle.this", "applications.ubuntu.to.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_model_bundle.stripped", "applications.ubuntu.to.#", "0"),
+					resource.TestCheckResourceAttrSet("data.juju_model_bundle.stripped", "applications.ubuntu.options.hostname"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceModelBundle(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "ubuntu" {
+  name       = "ubuntu"
+  model_uuid = juju_model.this.uuid
+
+  charm {
+    name    = "ubuntu"
+    channel = "latest/stable"
+  }
+}
+
+data "juju_model_bundle" "this" {
+  model_uuid = juju_application.ubuntu.model_uuid
+}
+
+data "juju_model_bundle" "stripped" {
+  model_uuid               = juju_application.ubuntu.model_uuid
+  include_charm_defaults   = true
+  strip_machine_placements = true
+}
+`, modelName)
+}
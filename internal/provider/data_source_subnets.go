@@ -0,0 +1,216 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &subnetsDataSource{}
+
+// NewSubnetsDataSource returns a new instance of the subnets data source.
+func NewSubnetsDataSource() datasource.DataSource {
+	return &subnetsDataSource{}
+}
+
+type subnetsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// subnetsDataSourceModel is the juju data stored by terraform.
+// tfsdk must match subnets data source schema attribute names.
+type subnetsDataSourceModel struct {
+	ModelUUID types.String `tfsdk:"model_uuid"`
+	Space     types.String `tfsdk:"space"`
+	Zone      types.String `tfsdk:"zone"`
+	Subnets   types.List   `tfsdk:"subnets"`
+}
+
+// nestedSubnet represents a single element of the subnets list.
+type nestedSubnet struct {
+	CIDR              types.String `tfsdk:"cidr"`
+	Space             types.String `tfsdk:"space"`
+	Zones             types.List   `tfsdk:"zones"`
+	VLANTag           types.Int64  `tfsdk:"vlan_tag"`
+	ProviderID        types.String `tfsdk:"provider_id"`
+	ProviderNetworkID types.String `tfsdk:"provider_network_id"`
+	ProviderSpaceID   types.String `tfsdk:"provider_space_id"`
+	Life              types.String `tfsdk:"life"`
+}
+
+// Metadata returns the full data source name as used in terraform plans.
+func (d *subnetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnets"
+}
+
+// Schema returns the schema for the subnets data source.
+func (d *subnetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the subnets discovered in a model, e.g. to move them into a `juju_space`.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The uuid of the model to list the subnets of.",
+				Required:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+			},
+			"space": schema.StringAttribute{
+				Description: "Only list the subnets in this space.",
+				Optional:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidSpace, "must be a valid space name"),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description: "Only list the subnets in this availability zone.",
+				Optional:    true,
+			},
+			"subnets": schema.ListNestedAttribute{
+				Description: "The subnets of the model, sorted by CIDR.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							Description: "The CIDR of the subnet.",
+							Computed:    true,
+						},
+						"space": schema.StringAttribute{
+							Description: "The name of the space containing the subnet.",
+							Computed:    true,
+						},
+						"zones": schema.ListAttribute{
+							Description: "The availability zones of the subnet.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"vlan_tag": schema.Int64Attribute{
+							Description: "The VLAN tag of the subnet, 0 if it is not a VLAN.",
+							Computed:    true,
+						},
+						"provider_id": schema.StringAttribute{
+							Description: "The ID of the subnet in the cloud provider.",
+							Computed:    true,
+						},
+						"provider_network_id": schema.StringAttribute{
+							Description: "The ID of the network containing the subnet in the cloud provider.",
+							Computed:    true,
+						},
+						"provider_space_id": schema.StringAttribute{
+							Description: "The ID of the space containing the subnet in the cloud provider.",
+							Computed:    true,
+						},
+						"life": schema.StringAttribute{
+							Description: "The life of the subnet, e.g. alive or dying.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *subnetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = provider.Client
+	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceSubnets)
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *subnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "subnets")
+		return
+	}
+
+	var data subnetsDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := d.client.Spaces.ListSubnets(&juju.ListSubnetsInput{
+		ModelUUID: data.ModelUUID.ValueString(),
+		Space:     data.Space.ValueString(),
+		Zone:      data.Zone.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list subnets, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read %d subnets of model %q", len(output.Subnets), data.ModelUUID.ValueString()))
+
+	subnets := make([]nestedSubnet, 0, len(output.Subnets))
+	for _, subnet := range output.Subnets {
+		zones, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(subnet.Zones))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		subnets = append(subnets, nestedSubnet{
+			CIDR:              types.StringValue(subnet.CIDR),
+			Space:             types.StringValue(subnet.Space),
+			Zones:             zones,
+			VLANTag:           types.Int64Value(int64(subnet.VLANTag)),
+			ProviderID:        types.StringValue(subnet.ProviderID),
+			ProviderNetworkID: types.StringValue(subnet.ProviderNetworkID),
+			ProviderSpaceID:   types.StringValue(subnet.ProviderSpaceID),
+			Life:              types.StringValue(subnet.Life),
+		})
+	}
+	subnetType := req.Config.Schema.GetAttributes()["subnets"].(schema.ListNestedAttribute).NestedObject.Type()
+	subnetsValue, diags := types.ListValueFrom(ctx, subnetType, subnets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Subnets = subnetsValue
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *subnetsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceSubnets, msg, additionalFields...)
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceSubnets(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-datasource-subnets-test-model")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSubnets(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.juju_subnets.all", "model_uuid", "juju_model.this", "uuid"),
+					resource.TestCheckResourceAttrSet("data.juju_subnets.all", "subnets.#"),
+					resource.TestCheckResourceAttr("data.juju_subnets.alpha", "space", "alpha"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSubnets(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+data "juju_subnets" "all" {
+  model_uuid = juju_model.this.uuid
+}
+
+data "juju_subnets" "alpha" {
+  model_uuid = juju_model.this.uuid
+  space      = "alpha"
+}
+`, modelName)
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	DestroyStorageKey = "destroy_storage"
+	ForceKey          = "force"
+	MaxWaitKey        = "max_wait"
+	NoWaitKey         = "no_wait"
+)
+
+// destroyOptionsModel holds the attributes controlling how Juju removes
+// an application or a model when the resource is destroyed.
+type destroyOptionsModel struct {
+	DestroyStorage types.Bool   `tfsdk:"destroy_storage"`
+	Force          types.Bool   `tfsdk:"force"`
+	MaxWait        types.String `tfsdk:"max_wait"`
+	NoWait         types.Bool   `tfsdk:"no_wait"`
+}
+
+// destroyOptionsAttributes returns the schema of the destroy options of
+// the given kind of resource, e.g. "application". The options are only
+// used when the resource is destroyed, changing them does not modify
+// the application or model.
+func destroyOptionsAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		DestroyStorageKey: schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether the storage of the %s is destroyed when the %s is destroyed. "+
+				"If false, the storage is released instead, so that it can be imported and reattached later. "+
+				"Defaults to true.", kind, kind),
+			Optional: true,
+		},
+		ForceKey: schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether the %s is forcefully destroyed, ignoring errors, e.g. of "+
+				"storage which cannot be removed.", kind),
+			Optional: true,
+		},
+		MaxWaitKey: schema.StringAttribute{
+			Description: fmt.Sprintf("How long each step of the forced removal of the %s waits before "+
+				"moving on to the next step, as a duration, e.g. \"5m\". Requires force to be set.", kind),
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot(ForceKey)),
+				ValidatorMatchString(func(s string) bool {
+					_, err := time.ParseDuration(s)
+					return err == nil
+				}, "must be a valid duration"),
+			},
+		},
+		NoWaitKey: schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether the forced removal of the %s rushes through each step "+
+				"without waiting for it to complete. Requires force to be set.", kind),
+			Optional: true,
+			Validators: []validator.Bool{
+				boolvalidator.AlsoRequires(path.MatchRoot(ForceKey)),
+				boolvalidator.ConflictsWith(path.MatchRoot(MaxWaitKey)),
+			},
+		},
+	}
+}
+
+// destroyArgs returns the arguments to pass to Juju when destroying the
+// application or model. A nil destroyStorage means the storage is
+// destroyed.
+func (m destroyOptionsModel) destroyArgs() (destroyStorage *bool, force bool, maxWait *time.Duration, err error) {
+	destroyStorage = m.DestroyStorage.ValueBoolPointer()
+	force = m.Force.ValueBool()
+	if m.NoWait.ValueBool() {
+		maxWait = new(time.Duration)
+	} else if m.MaxWait.ValueString() != "" {
+		wait, err := time.ParseDuration(m.MaxWait.ValueString())
+		if err != nil {
+			return nil, false, nil, err
+		}
+		maxWait = &wait
+	}
+	return destroyStorage, force, maxWait, nil
+}
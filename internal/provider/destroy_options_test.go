@@ -0,0 +1,46 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDestroyArgs(t *testing.T) {
+	destroyStorage, force, maxWait, err := destroyOptionsModel{}.destroyArgs()
+	require.NoError(t, err)
+	assert.Nil(t, destroyStorage)
+	assert.False(t, force)
+	assert.Nil(t, maxWait)
+
+	destroyStorage, force, maxWait, err = destroyOptionsModel{
+		DestroyStorage: types.BoolValue(false),
+		Force:          types.BoolValue(true),
+		MaxWait:        types.StringValue("5m"),
+	}.destroyArgs()
+	require.NoError(t, err)
+	require.NotNil(t, destroyStorage)
+	assert.False(t, *destroyStorage)
+	assert.True(t, force)
+	require.NotNil(t, maxWait)
+	assert.Equal(t, 5*time.Minute, *maxWait)
+
+	_, _, maxWait, err = destroyOptionsModel{
+		Force:  types.BoolValue(true),
+		NoWait: types.BoolValue(true),
+	}.destroyArgs()
+	require.NoError(t, err)
+	require.NotNil(t, maxWait)
+	assert.Equal(t, time.Duration(0), *maxWait)
+
+	_, _, _, err = destroyOptionsModel{
+		MaxWait: types.StringValue("soon"),
+	}.destroyArgs()
+	assert.Error(t, err)
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &actionResultEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &actionResultEphemeralResource{}
+
+// NewActionResultEphemeralResource returns a new instance of the action
+// result ephemeral resource, which returns the results of a charm action
+// without storing them in the Terraform state.
+func NewActionResultEphemeralResource() ephemeral.EphemeralResource {
+	return &actionResultEphemeralResource{}
+}
+
+type actionResultEphemeralResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type actionResultEphemeralResourceModel struct {
+	ModelUUID   types.String   `tfsdk:"model_uuid"`
+	OperationID types.String   `tfsdk:"operation_id"`
+	Application types.String   `tfsdk:"application"`
+	Units       types.Set      `tfsdk:"units"`
+	Action      types.String   `tfsdk:"action"`
+	Parameters  types.Dynamic  `tfsdk:"parameters"`
+	Results     types.Map      `tfsdk:"results"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (e *actionResultEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action_result"
+}
+
+func (e *actionResultEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "An ephemeral resource that returns the results of a charm action without storing them " +
+			"in the Terraform plan or state. Either the results of an operation which already ran, e.g. by a " +
+			"`juju_action` resource, are read, or the action is run every time the ephemeral resource is " +
+			"opened, i.e. during every plan and apply. Only actions which can safely run many times, such " +
+			"as actions reading a password, should be run this way.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The UUID of the model of the application.",
+				Required:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+			},
+			"operation_id": schema.StringAttribute{
+				Description: "The ID of an operation to read the results of. Exactly one of operation_id or action must be specified.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("action")),
+				},
+			},
+			"application": schema.StringAttribute{
+				Description: "The name of the application to run the action on.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("action")),
+					ValidatorMatchString(names.IsValidApplication, "must be a valid application name"),
+				},
+			},
+			"units": schema.SetAttribute{
+				Description: "The names of the units to run the action on. The action runs on the leader " +
+					"unit of the application if not set.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"action": schema.StringAttribute{
+				Description: "The name of the action to run, as defined by the charm.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("application")),
+				},
+			},
+			"parameters": schema.DynamicAttribute{
+				Description: "The parameters of the action, as an object.",
+				Optional:    true,
+			},
+			"results": schema.MapAttribute{
+				Description: "The results of the action, keyed by the name of the unit which ran it. " +
+					"Nested results are flattened, with their keys joined by a dot.",
+				ElementType: actionResultsType.ElemType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (e *actionResultEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = provider.Client
+	e.subCtx = tflog.NewSubsystem(ctx, LogEphemeralActionResult)
+}
+
+// Open runs the action if needed, then waits for the operation to
+// complete and returns its results.
+func (e *actionResultEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Prevent panic if the provider has not been configured.
+	if e.client == nil {
+		addEphemeralClientNotConfiguredError(&resp.Diagnostics, "action_result")
+		return
+	}
+
+	var data actionResultEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	openTimeout, diags := data.Timeouts.Open(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelUUID := data.ModelUUID.ValueString()
+	operationID := data.OperationID.ValueString()
+	if operationID == "" {
+		var units []string
+		resp.Diagnostics.Append(data.Units.ElementsAs(ctx, &units, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		parameters, err := actionParameters(data.Parameters)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid Parameters", err.Error())
+			return
+		}
+		runResp, err := e.client.Actions.RunAction(&juju.RunActionInput{
+			ModelUUID:       modelUUID,
+			ApplicationName: data.Application.ValueString(),
+			Units:           units,
+			ActionName:      data.Action.ValueString(),
+			Parameters:      parameters,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run action, got error: %s", err))
+			return
+		}
+		operationID = runResp.OperationID
+		e.trace(fmt.Sprintf("running action %q", data.Action.ValueString()), map[string]interface{}{
+			"operation": operationID,
+		})
+	}
+
+	operation, err := waitForOperation(ctx, e.client, modelUUID, operationID, openTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Operation %q did not complete, got error: %s", operationID, err))
+		return
+	}
+
+	results, dErr := types.MapValueFrom(ctx, actionResultsType.ElemType, actionResults(operation.Tasks))
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	data.OperationID = types.StringValue(operationID)
+	data.Results = results
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *actionResultEphemeralResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if e.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(e.subCtx, LogEphemeralActionResult, msg, additionalFields...)
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EphemeralActionResult(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-ephemeral-action-result")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralActionResult(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("echo.action", "data.operation_id", "juju_action.this", "operation_id"),
+					resource.TestCheckResourceAttrSet("echo.action", "data.results.test-app/0.fortune"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralActionResult(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model_uuid = juju_model.this.uuid
+  name       = "test-app"
+
+  charm {
+    name = "juju-qa-test"
+    base = "ubuntu@22.04"
+  }
+
+  wait_for {
+    workload_status = ["active", "unknown"]
+    agent_status    = "idle"
+  }
+}
+
+resource "juju_action" "this" {
+  model_uuid  = juju_model.this.uuid
+  application = juju_application.this.name
+  action      = "fortune"
+  sensitive   = true
+}
+
+ephemeral "juju_action_result" "this" {
+  model_uuid   = juju_model.this.uuid
+  operation_id = juju_action.this.operation_id
+}
+
+provider "echo" {
+  data = ephemeral.juju_action_result.this
+}
+
+resource "echo" "action" {}
+`, modelName)
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &secretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &secretEphemeralResource{}
+
+// NewSecretEphemeralResource returns a new instance of the secret
+// ephemeral resource, which reveals the value of a secret without
+// storing it in the Terraform state.
+func NewSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &secretEphemeralResource{}
+}
+
+type secretEphemeralResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// secretEphemeralResourceModel is the juju data of an opened secret.
+// tfsdk must match secret ephemeral resource schema attribute names.
+type secretEphemeralResourceModel struct {
+	ModelUUID types.String `tfsdk:"model_uuid"`
+	Name      types.String `tfsdk:"name"`
+	SecretId  types.String `tfsdk:"secret_id"`
+	Revision  types.Int64  `tfsdk:"revision"`
+	Value     types.Map    `tfsdk:"value"`
+}
+
+func (e *secretEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (e *secretEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "An ephemeral resource that reveals the value of a Juju user secret. " +
+			"The value is never stored in the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The uuid of the model containing the secret.",
+				Required:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the secret. Exactly one of name or secret_id must be specified.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("secret_id")),
+				},
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "The ID of the secret.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"revision": schema.Int64Attribute{
+				Description: "The revision of the secret to reveal. Defaults to the latest revision.",
+				Optional:    true,
+			},
+			"value": schema.MapAttribute{
+				Description: "The value of the secret.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (e *secretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = provider.Client
+	e.subCtx = tflog.NewSubsystem(ctx, LogEphemeralSecret)
+}
+
+// Open reveals the secret. The value is returned to Terraform in the
+// result only, it is not persisted.
+func (e *secretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Prevent panic if the provider has not been configured.
+	if e.client == nil {
+		addEphemeralClientNotConfiguredError(&resp.Diagnostics, "secret")
+		return
+	}
+
+	var data secretEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readSecretInput := juju.ReadSecretInput{
+		ModelUUID: data.ModelUUID.ValueString(),
+		SecretId:  data.SecretId.ValueString(),
+	}
+	if readSecretInput.SecretId == "" {
+		readSecretInput.Name = data.Name.ValueStringPointer()
+	}
+	if !data.Revision.IsNull() {
+		readSecretInput.Revision = intPtr(data.Revision)
+	}
+
+	readSecretOutput, err := e.client.Secrets.ReadSecret(&readSecretInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
+		return
+	}
+	e.trace(fmt.Sprintf("opened secret ephemeral resource %q", readSecretOutput.SecretId))
+
+	data.SecretId = types.StringValue(readSecretOutput.SecretId)
+	data.Name = types.StringValue(readSecretOutput.Name)
+	value, dErr := types.MapValueFrom(ctx, types.StringType, readSecretOutput.Value)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	data.Value = value
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *secretEphemeralResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if e.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(e.subCtx, LogEphemeralSecret, msg, additionalFields...)
+}
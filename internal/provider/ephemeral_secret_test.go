@@ -0,0 +1,73 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestAcc_EphemeralSecret(t *testing.T) {
+	version := os.Getenv("JUJU_AGENT_VERSION")
+	if version == "" || internaltesting.CompareVersions(version, "3.3.0") < 0 {
+		t.Skip("JUJU_AGENT_VERSION is not set or is below 3.3.0")
+	}
+	modelName := acctest.RandomWithPrefix("tf-ephemeral-secret-test-model")
+	// ...-test-[0-9]+ is not a valid secret name, need to remove the dash before numbers
+	secretName := fmt.Sprintf("tf-ephemeral-secret-test%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralSecret(modelName, secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.secret", "data.value.key1", "value1"),
+					resource.TestCheckResourceAttr("echo.secret", "data.value.key2", "value2"),
+					resource.TestCheckResourceAttr("echo.secret", "data.name", secretName),
+					resource.TestCheckResourceAttrPair("echo.secret", "data.secret_id", "juju_secret.this", "secret_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralSecret(modelName, secretName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_secret" "this" {
+  model_uuid = juju_model.this.uuid
+  name       = %q
+  value = {
+    key1 = "value1"
+    key2 = "value2"
+  }
+}
+
+ephemeral "juju_secret" "this" {
+  model_uuid = juju_model.this.uuid
+  secret_id  = juju_secret.this.secret_id
+}
+
+provider "echo" {
+  data = ephemeral.juju_secret.this
+}
+
+resource "echo" "secret" {}
+`, modelName, secretName)
+}
@@ -4,10 +4,19 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/retry"
 )
 
 // model names for logging
@@ -19,21 +28,32 @@ const (
 	LogDataSourceApplication = "datasource-application"
 	LogDataSourceMachine     = "datasource-machine"
 	LogDataSourceModel       = "datasource-model"
+	LogDataSourceModelBundle = "datasource-model-bundle"
 	LogDataSourceOffer       = "datasource-offer"
 	LogDataSourceSecret      = "datasource-secret"
 	LogDataSourceStoragePool = "datasource-storage-pool"
+	LogDataSourceSubnets     = "datasource-subnets"
+
+	LogEphemeralActionResult = "ephemeral-action-result"
+	LogEphemeralSecret       = "ephemeral-secret"
 
+	LogResourceAction          = "resource-action"
 	LogResourceApplication     = "resource-application"
 	LogResourceAccessModel     = "resource-access-model"
 	LogResourceAccessOffer     = "resource-access-offer"
+	LogResourceBundle          = "resource-bundle"
 	LogResourceCredential      = "resource-credential"
 	LogResourceKubernetesCloud = "resource-kubernetes-cloud"
 	LogResourceMachine         = "resource-machine"
 	LogResourceModel           = "resource-model"
+	LogResourceModelDefaults   = "resource-model-defaults"
 	LogResourceOffer           = "resource-offer"
 	LogResourceSSHKey          = "resource-sshkey"
 	LogResourceUser            = "resource-user"
 	LogResourceSecret          = "resource-secret"
+	LogResourceSecretBackend   = "resource-secret-backend"
+	LogResourceSAAS            = "resource-saas"
+	LogResourceSpace           = "resource-space"
 	LogResourceAccessSecret    = "resource-access-secret"
 	LogResourceStoragePool     = "resource-storage-pool"
 
@@ -49,10 +69,80 @@ const (
 	LogResourceJAASAccessSvcAcc     = "resource-jaas-access-service-account"
 	LogResourceJAASGroup            = "resource-jaas-group"
 	LogResourceJAASRole             = "resource-jaas-role"
+	LogResourceJAASServiceAccount   = "resource-jaas-service-account"
 )
 
 const LogResourceIntegration = "resource-integration"
 
+// Default values of the operations in the timeouts block of resources,
+// matching the defaults of the wait package.
+const (
+	defaultCreateTimeout = 30 * time.Minute
+	defaultReadTimeout   = 30 * time.Minute
+	defaultUpdateTimeout = 30 * time.Minute
+	defaultDeleteTimeout = 15 * time.Minute
+)
+
+// timeoutDescription returns the description of an operation of a
+// timeouts block, which bounds the wait for the given event.
+func timeoutDescription(waitFor string, defaultTimeout time.Duration) string {
+	// Drop the zero seconds and minutes, e.g. "30m" rather than "30m0s".
+	defaultValue := defaultTimeout.String()
+	if strings.HasSuffix(defaultValue, "m0s") {
+		defaultValue = strings.TrimSuffix(defaultValue, "0s")
+	}
+	if strings.HasSuffix(defaultValue, "h0m") {
+		defaultValue = strings.TrimSuffix(defaultValue, "0m")
+	}
+	return fmt.Sprintf("The maximum time to wait for %s, as a duration such as \"30s\" or \"2h45m\". "+
+		"Valid time units are \"s\" (seconds), \"m\" (minutes), \"h\" (hours). Defaults to %s.",
+		waitFor, defaultValue)
+}
+
+// nullTimeouts returns the null timeouts block of the given schema, for
+// prior states which had no timeouts block.
+func nullTimeouts(s schema.Schema) timeouts.Value {
+	attrTypes := s.Blocks["timeouts"].Type().(attr.TypeWithAttributeTypes).AttributeTypes()
+	return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+}
+
+// upgradeTimeouts converts the timeouts block of a prior state, which may
+// have had fewer operations in it, to the timeouts block of the current
+// schema. The values of the operations found in both are kept.
+func upgradeTimeouts(ctx context.Context, prior timeouts.Value, s schema.Schema) (timeouts.Value, diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return nullTimeouts(s), nil
+	}
+	attrTypes := s.Blocks["timeouts"].Type().(attr.TypeWithAttributeTypes).AttributeTypes()
+	priorAttrs := prior.Attributes()
+	attrs := make(map[string]attr.Value, len(attrTypes))
+	for name := range attrTypes {
+		if value, ok := priorAttrs[name]; ok {
+			attrs[name] = value
+		} else {
+			attrs[name] = types.StringNull()
+		}
+	}
+	object, diags := types.ObjectValue(attrTypes, attrs)
+	return timeouts.Value{Object: object}, diags
+}
+
+// retryOnConnectionRefused calls do until it does not fail with
+// juju.ConnectionRefusedError, for at most the given timeout.
+func retryOnConnectionRefused(ctx context.Context, timeout time.Duration, do func() error) error {
+	_, err := retry.RetryOnErrors(retry.RetryOnErrorsCfg[struct{}, struct{}]{
+		Context: ctx,
+		Do: func(struct{}) (struct{}, error) {
+			return struct{}{}, do()
+		},
+		RetriableErrors: []error{juju.ConnectionRefusedError},
+		RetryConf: &retry.RetryConf{
+			MaxDuration: timeout,
+		},
+	})
+	return err
+}
+
 func addClientNotConfiguredError(diag *diag.Diagnostics, resource, method string) {
 	diag.AddError(
 		"Provider Error, Client Not Configured",
@@ -69,6 +159,14 @@ func addDSClientNotConfiguredError(diag *diag.Diagnostics, dataSource string) {
 	)
 }
 
+func addEphemeralClientNotConfiguredError(diag *diag.Diagnostics, ephemeralResource string) {
+	diag.AddError(
+		"Provider Error, Client Not Configured",
+		fmt.Sprintf("Unable to open ephemeral resource %s. Expected configured Juju Client. "+
+			"Please report this issue to the provider developers.", ephemeralResource),
+	)
+}
+
 func intPtr(value types.Int64) *int {
 	count := int(value.ValueInt64())
 	return &count
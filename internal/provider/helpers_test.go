@@ -0,0 +1,86 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func TestUpgradeTimeouts(t *testing.T) {
+	ctx := context.Background()
+	currentSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+
+	null := nullTimeouts(currentSchema)
+	assert.True(t, null.IsNull())
+	assert.True(t, null.Type(ctx).Equal(currentSchema.Blocks["timeouts"].Type()))
+
+	upgraded, diags := upgradeTimeouts(ctx, timeouts.Value{}, currentSchema)
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, upgraded.IsNull())
+	assert.True(t, upgraded.Type(ctx).Equal(currentSchema.Blocks["timeouts"].Type()))
+
+	prior := timeouts.Value{Object: types.ObjectValueMust(
+		map[string]attr.Type{"create": types.StringType},
+		map[string]attr.Value{"create": types.StringValue("31m")},
+	)}
+	upgraded, diags = upgradeTimeouts(ctx, prior, currentSchema)
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, upgraded.Type(ctx).Equal(currentSchema.Blocks["timeouts"].Type()))
+	createTimeout, diags := upgraded.Create(ctx, time.Minute)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, 31*time.Minute, createTimeout)
+	deleteTimeout, diags := upgraded.Delete(ctx, time.Minute)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, time.Minute, deleteTimeout)
+}
+
+func TestRetryOnConnectionRefused(t *testing.T) {
+	ctx := context.Background()
+
+	calls := 0
+	err := retryOnConnectionRefused(ctx, time.Minute, func() error {
+		calls++
+		if calls == 1 {
+			return juju.ConnectionRefusedError
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	calls = 0
+	err = retryOnConnectionRefused(ctx, time.Minute, func() error {
+		calls++
+		return juju.ModelNotFoundError
+	})
+	assert.ErrorIs(t, err, juju.ModelNotFoundError)
+	assert.Equal(t, 1, calls)
+}
+
+func TestTimeoutDescription(t *testing.T) {
+	assert.Contains(t, timeoutDescription("the model to be destroyed", 15*time.Minute), "wait for the model to be destroyed, as a duration")
+	assert.Contains(t, timeoutDescription("the model to be destroyed", 15*time.Minute), "Defaults to 15m.")
+	assert.Contains(t, timeoutDescription("the model to be destroyed", 30*time.Second), "Defaults to 30s.")
+	assert.Contains(t, timeoutDescription("the model to be destroyed", 2*time.Hour), "Defaults to 2h.")
+	assert.Contains(t, timeoutDescription("the model to be destroyed", 90*time.Minute), "Defaults to 1h30m.")
+}
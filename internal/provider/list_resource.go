@@ -0,0 +1,164 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// resourceIdentityModel is the identity of the resources which can be
+// listed. It holds the same value as the ID of the resource, so that
+// resources can be imported by identity as well as by ID.
+type resourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// idIdentitySchema returns the identity schema of the resources which can
+// be listed, the format of the ID is described by idDescription.
+func idIdentitySchema(idDescription string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       idDescription,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setResourceIdentity sets the identity of a resource from its ID.
+func setResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, resourceIdentityModel{ID: id})
+}
+
+// modelUUIDListSchema returns the schema of the list blocks of resources
+// which are listed from a single model.
+func modelUUIDListSchema(kind string) listschema.Schema {
+	return listschema.Schema{
+		Description: fmt.Sprintf("Lists the %s of a model.", kind),
+		Attributes: map[string]listschema.Attribute{
+			"model_uuid": listschema.StringAttribute{
+				Description: fmt.Sprintf("The UUID of the model to list %s from.", kind),
+				Required:    true,
+			},
+		},
+	}
+}
+
+// modelUUIDListConfig is the configuration of the list blocks described by
+// modelUUIDListSchema.
+type modelUUIDListConfig struct {
+	ModelUUID types.String `tfsdk:"model_uuid"`
+}
+
+// listedResource identifies a resource found by a list resource.
+type listedResource struct {
+	// ID is the ID the resource is imported with.
+	ID string
+	// DisplayName is shown to the user when listing resources.
+	DisplayName string
+}
+
+// listResults streams the results of a list request for the given
+// resources. Each result is built as a Terraform import would build it:
+// the resource is imported by its ID and, if the resource data was
+// requested, read afterwards. This way the generated configuration
+// matches the one of an imported resource.
+func listResults(ctx context.Context, r resource.ResourceWithImportState, req list.ListRequest, resources []listedResource) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, listed := range resources {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			if !push(listResult(ctx, r, req, listed)) {
+				return
+			}
+		}
+	}
+}
+
+func listResult(ctx context.Context, r resource.ResourceWithImportState, req list.ListRequest, listed listedResource) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = listed.DisplayName
+	result.Diagnostics.Append(setResourceIdentity(ctx, result.Identity, types.StringValue(listed.ID))...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	importResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: req.ResourceSchema, Raw: result.Resource.Raw},
+		Identity: result.Identity,
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: listed.ID}, &importResp)
+	result.Diagnostics.Append(importResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+	result.Identity = importResp.Identity
+	if !req.IncludeResource {
+		return result
+	}
+
+	readResp := resource.ReadResponse{
+		State:    importResp.State,
+		Identity: importResp.Identity,
+	}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+	result.Resource = &tfsdk.Resource{Schema: readResp.State.Schema, Raw: readResp.State.Raw}
+	result.Identity = readResp.Identity
+	return result
+}
+
+// listModelResources lists the resources of the model configured in the
+// list block, which are found by listModel.
+func listModelResources(
+	ctx context.Context,
+	r resource.ResourceWithImportState,
+	client *juju.Client,
+	kind string,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+	listModel func(modelUUID string) ([]listedResource, error),
+) {
+	var diags diag.Diagnostics
+	if client == nil {
+		addClientNotConfiguredError(&diags, kind, "list")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var config modelUUIDListConfig
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	listed, err := listModel(config.ModelUUID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list %ss of model %q, got error: %s", kind, config.ModelUUID.ValueString(), err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	stream.Results = listResults(ctx, r, req, listed)
+}
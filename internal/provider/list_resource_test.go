@@ -0,0 +1,211 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// listTestResource is a resource recording the IDs it is imported and
+// read with, its name is only known once read.
+type listTestResource struct {
+	imported []string
+	read     []string
+}
+
+type listTestResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *listTestResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "juju_test"
+}
+
+func (r *listTestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (r *listTestResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (r *listTestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state listTestResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.read = append(r.read, state.ID.ValueString())
+	state.Name = types.StringValue("name of " + state.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *listTestResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (r *listTestResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (r *listTestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.imported = append(r.imported, req.ID)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *listTestResource) listRequest(includeResource bool, limit int64) list.ListRequest {
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return list.ListRequest{
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: idIdentitySchema("The ID of the test resource."),
+	}
+}
+
+func collectListResults(t *testing.T, r *listTestResource, req list.ListRequest, listed []listedResource) []list.ListResult {
+	var results []list.ListResult
+	for result := range listResults(context.Background(), r, req, listed) {
+		require.False(t, result.Diagnostics.HasError(), "%v", result.Diagnostics)
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestListResults(t *testing.T) {
+	ctx := context.Background()
+	r := &listTestResource{}
+	listed := []listedResource{
+		{ID: "model:one", DisplayName: "one"},
+		{ID: "model:two", DisplayName: "two"},
+	}
+
+	results := collectListResults(t, r, r.listRequest(true, 0), listed)
+	require.Len(t, results, 2)
+	assert.Equal(t, []string{"model:one", "model:two"}, r.imported)
+	assert.Equal(t, []string{"model:one", "model:two"}, r.read)
+	for i, result := range results {
+		assert.Equal(t, listed[i].DisplayName, result.DisplayName)
+
+		var identity resourceIdentityModel
+		require.False(t, result.Identity.Get(ctx, &identity).HasError())
+		assert.Equal(t, listed[i].ID, identity.ID.ValueString())
+
+		var state listTestResourceModel
+		require.False(t, result.Resource.Get(ctx, &state).HasError())
+		assert.Equal(t, listed[i].ID, state.ID.ValueString())
+		assert.Equal(t, "name of "+listed[i].ID, state.Name.ValueString())
+	}
+}
+
+func TestListResultsWithoutResource(t *testing.T) {
+	ctx := context.Background()
+	r := &listTestResource{}
+	listed := []listedResource{
+		{ID: "model:one", DisplayName: "one"},
+		{ID: "model:two", DisplayName: "two"},
+	}
+
+	results := collectListResults(t, r, r.listRequest(false, 1), listed)
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{"model:one"}, r.imported)
+	assert.Empty(t, r.read, "resources are only read when their data is requested")
+
+	var identity resourceIdentityModel
+	require.False(t, results[0].Identity.Get(ctx, &identity).HasError())
+	assert.Equal(t, "model:one", identity.ID.ValueString())
+}
+
+// testAccCheckListed checks that the resource named resourceName is found
+// when listing the resources of its type, with the identity of the
+// resource and the state read after importing it. configAttrs maps the
+// attributes of the list block to the attributes of the resource they are
+// read from, the attributes in checkAttrs must be equal once read.
+func testAccCheckListed(newListResource func() list.ListResource, resourceName string, configAttrs map[string]string, checkAttrs ...string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+
+		ctx := context.Background()
+		lr := newListResource()
+		if withConfigure, ok := lr.(list.ListResourceWithConfigure); ok {
+			var configureResp resource.ConfigureResponse
+			withConfigure.Configure(ctx, resource.ConfigureRequest{ProviderData: juju.ProviderData{Client: TestClient}}, &configureResp)
+			if configureResp.Diagnostics.HasError() {
+				return fmt.Errorf("configuring list resource: %v", configureResp.Diagnostics)
+			}
+		}
+		r, ok := lr.(resource.ResourceWithIdentity)
+		if !ok {
+			return fmt.Errorf("list resource of %q has no identity", resourceName)
+		}
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		var identitySchemaResp resource.IdentitySchemaResponse
+		r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+		var listSchemaResp list.ListResourceSchemaResponse
+		lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchemaResp)
+
+		config := make(map[string]tftypes.Value, len(configAttrs))
+		for attr, stateAttr := range configAttrs {
+			config[attr] = tftypes.NewValue(tftypes.String, rs.Primary.Attributes[stateAttr])
+		}
+		req := list.ListRequest{
+			Config: tfsdk.Config{
+				Schema: listSchemaResp.Schema,
+				Raw:    tftypes.NewValue(listSchemaResp.Schema.Type().TerraformType(ctx), config),
+			},
+			IncludeResource:        true,
+			ResourceSchema:         schemaResp.Schema,
+			ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+		}
+		var stream list.ListResultsStream
+		lr.List(ctx, req, &stream)
+
+		for result := range stream.Results {
+			if result.Diagnostics.HasError() {
+				return fmt.Errorf("listing %q: %v", resourceName, result.Diagnostics)
+			}
+			var identity resourceIdentityModel
+			if diags := result.Identity.Get(ctx, &identity); diags.HasError() {
+				return fmt.Errorf("reading identity of listed %q: %v", resourceName, diags)
+			}
+			if identity.ID.ValueString() != rs.Primary.ID {
+				continue
+			}
+			for _, attr := range append([]string{"id"}, checkAttrs...) {
+				var value types.String
+				if diags := result.Resource.GetAttribute(ctx, path.Root(attr), &value); diags.HasError() {
+					return fmt.Errorf("reading %q of listed %q: %v", attr, resourceName, diags)
+				}
+				if value.ValueString() != rs.Primary.Attributes[attr] {
+					return fmt.Errorf("listed %q has %s %q, expected %q", resourceName, attr, value.ValueString(), rs.Primary.Attributes[attr])
+				}
+			}
+			return nil
+		}
+		return fmt.Errorf("%q with ID %q not listed", resourceName, rs.Primary.ID)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// localCharmPathRequiresReplaceIf requires the application to be replaced
+// when switching between a Charmhub charm and a local charm. Changing the
+// path of a local charm refreshes the application in place.
+func localCharmPathRequiresReplaceIf(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+}
+
+// localCharmHashModifier computes the hash of the local charm found at
+// the sibling path attribute. A difference between the planned hash and
+// the hash in state means the charm has been rebuilt and the application
+// has to be refreshed.
+type localCharmHashModifier struct{}
+
+// Description returns a plain text description of the modifier's behavior.
+func (m localCharmHashModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior.
+func (m localCharmHashModifier) MarkdownDescription(_ context.Context) string {
+	return "Computes the hash of the local charm at path during planning."
+}
+
+// PlanModifyString sets the planned hash to the hash of the local charm.
+func (m localCharmHashModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	charmPathPath := req.Path.ParentPath().AtName(CharmPathKey)
+	hash, known, diags := plannedLocalCharmHash(ctx, req.Plan, charmPathPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	switch {
+	case !known:
+		resp.PlanValue = types.StringUnknown()
+	case hash == "":
+		resp.PlanValue = types.StringNull()
+	default:
+		resp.PlanValue = types.StringValue(hash)
+	}
+}
+
+// localCharmRevisionModifier marks the charm revision as unknown when a
+// local charm has been rebuilt, as the controller assigns a new revision
+// to every upload.
+type localCharmRevisionModifier struct{}
+
+// Description returns a plain text description of the modifier's behavior.
+func (m localCharmRevisionModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior.
+func (m localCharmRevisionModifier) MarkdownDescription(_ context.Context) string {
+	return "Marks the revision as unknown when the local charm has changed."
+}
+
+// PlanModifyInt64 sets the planned revision to unknown if the local charm
+// differs from the one in state.
+func (m localCharmRevisionModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// Nothing to compare against on create, nor to plan on destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	charmPathPath := req.Path.ParentPath().AtName(CharmPathKey)
+	hash, known, diags := plannedLocalCharmHash(ctx, req.Plan, charmPathPath)
+	// Errors reading the charm are reported by the hash modifier.
+	if diags.HasError() || hash == "" && known {
+		return
+	}
+
+	var statePath, stateHash types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, charmPathPath, &statePath)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, req.Path.ParentPath().AtName(CharmHashKey), &stateHash)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !known || stateHash.ValueString() != hash {
+		resp.PlanValue = types.Int64Unknown()
+	}
+}
+
+// plannedLocalCharmHash returns the hash of the local charm at the planned
+// value of charmPathPath. An empty hash is returned if no local charm is
+// used, and known is false if the path is not yet known.
+func plannedLocalCharmHash(ctx context.Context, plan tfsdk.Plan, charmPathPath path.Path) (hash string, known bool, diags diag.Diagnostics) {
+	var charmPath types.String
+	diags.Append(plan.GetAttribute(ctx, charmPathPath, &charmPath)...)
+	if diags.HasError() {
+		return "", false, diags
+	}
+	if charmPath.IsUnknown() {
+		return "", false, diags
+	}
+	if charmPath.IsNull() {
+		return "", true, diags
+	}
+	hash, err := juju.LocalCharmHash(charmPath.ValueString())
+	if err != nil {
+		diags.AddAttributeError(charmPathPath, "Invalid Local Charm",
+			fmt.Sprintf("Unable to read local charm %q, got error: %s", charmPath.ValueString(), err))
+		return "", false, diags
+	}
+	return hash, true, diags
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/juju/juju/core/crossmodel"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+const OfferControllerKey = "offer_controller"
+
+// nestedOfferController represents the offer_controller block, i.e. how
+// to reach the controller hosting an offer consumed across controllers.
+type nestedOfferController struct {
+	ControllerAddrs types.String `tfsdk:"controller_addresses"`
+	CACert          types.String `tfsdk:"ca_certificate"`
+	UserName        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	ClientID        types.String `tfsdk:"client_id"`
+	ClientSecret    types.String `tfsdk:"client_secret"`
+}
+
+// controllerConfiguration returns the configuration to connect to the
+// controller hosting the offer.
+func (c nestedOfferController) controllerConfiguration() *juju.ControllerConfiguration {
+	config := &juju.ControllerConfiguration{
+		CACert:       c.CACert.ValueString(),
+		Username:     c.UserName.ValueString(),
+		Password:     c.Password.ValueString(),
+		ClientID:     c.ClientID.ValueString(),
+		ClientSecret: c.ClientSecret.ValueString(),
+	}
+	if addrs := c.ControllerAddrs.ValueString(); addrs != "" {
+		config.ControllerAddresses = strings.Split(addrs, ",")
+	}
+	return config
+}
+
+// offerControllerBlock returns the schema of the offer_controller block
+// of the given kind of resource, e.g. "integration".
+func offerControllerBlock(kind string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: fmt.Sprintf("The controller hosting the offer, when the offer_url is prefixed with the name of "+
+			"another controller. If not set, the controller and its account are read from the local "+
+			"Juju client store, i.e. the controllers.yaml and accounts.yaml files of the `JUJU_DATA` "+
+			"directory. The details are only used to consume the offer when the %s is created.", kind),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"controller_addresses": schema.StringAttribute{
+					Description: "The addresses of the controller hosting the offer, in this format: " +
+						"<host>:<port>,<host>:<port>,....",
+					Required: true,
+				},
+				"ca_certificate": schema.StringAttribute{
+					Description: "The CA certificate of the controller hosting the offer.",
+					Optional:    true,
+				},
+				"username": schema.StringAttribute{
+					Description: "The username to log in to the controller hosting the offer.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("password")),
+						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("client_id")),
+					},
+				},
+				"password": schema.StringAttribute{
+					Description: "The password of the username.",
+					Optional:    true,
+					Sensitive:   true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("username")),
+					},
+				},
+				"client_id": schema.StringAttribute{
+					Description: "If the offer is hosted by JAAS: the client ID to log in with.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_secret")),
+					},
+				},
+				"client_secret": schema.StringAttribute{
+					Description: "If the offer is hosted by JAAS: the client secret of the client ID.",
+					Optional:    true,
+					Sensitive:   true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_id")),
+					},
+				},
+			},
+			Validators: []validator.Object{
+				objectvalidator.AtLeastOneOf(
+					path.MatchRelative().AtName("username"),
+					path.MatchRelative().AtName("client_id"),
+				),
+			},
+		},
+	}
+}
+
+// sourceController returns the configuration to connect to the
+// controller hosting the offer from the offer_controller block, or nil
+// if the block is not set.
+func sourceController(ctx context.Context, offerController types.List) (*juju.ControllerConfiguration, diag.Diagnostics) {
+	var offerControllers []nestedOfferController
+	diags := offerController.ElementsAs(ctx, &offerControllers, false)
+	if diags.HasError() || len(offerControllers) == 0 {
+		return nil, diags
+	}
+	return offerControllers[0].controllerConfiguration(), diags
+}
+
+// offerURLHasSource returns whether the offer URL is prefixed with the
+// name of the controller hosting the offer.
+func offerURLHasSource(offerURL string) bool {
+	url, err := crossmodel.ParseOfferURL(offerURL)
+	return err == nil && url.Source != ""
+}
+
+// sameOfferIgnoringSource returns whether the configured offer URL and
+// the offer URL read from Juju refer to the same offer, either of them
+// being possibly prefixed with the name of the controller hosting the
+// offer.
+func sameOfferIgnoringSource(configured, read string) bool {
+	configuredURL, err := crossmodel.ParseOfferURL(configured)
+	if err != nil {
+		return false
+	}
+	readURL, err := crossmodel.ParseOfferURL(read)
+	if err != nil {
+		return false
+	}
+	return configuredURL.AsLocal().String() == readURL.AsLocal().String()
+}
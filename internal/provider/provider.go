@@ -16,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -34,6 +36,7 @@ const (
 	JujuCACertEnvKey         = "JUJU_CA_CERT"
 	JujuClientIDEnvKey       = "JUJU_CLIENT_ID"
 	JujuClientSecretEnvKey   = "JUJU_CLIENT_SECRET"
+	JujuControllerNameEnvKey = "JUJU_CONTROLLER"
 	SkipFailedDeletionEnvKey = "JUJU_SKIP_FAILED_DELETION"
 
 	JujuController     = "controller_addresses"
@@ -42,6 +45,7 @@ const (
 	JujuClientID       = "client_id"
 	JujuClientSecret   = "client_secret"
 	JujuCACert         = "ca_certificate"
+	JujuControllerName = "controller_name"
 	SkipFailedDeletion = "skip_failed_deletion"
 
 	TwoSourcesAuthWarning = "Two sources of identity for controller login"
@@ -63,32 +67,37 @@ func jujuProviderModelEnvVar(diags diag.Diagnostics) jujuProviderModel {
 		CACert:             getEnvVar(JujuCACertEnvKey),
 		ClientID:           getEnvVar(JujuClientIDEnvKey),
 		ClientSecret:       getEnvVar(JujuClientSecretEnvKey),
+		ControllerName:     getEnvVar(JujuControllerNameEnvKey),
 		UserName:           getEnvVar(JujuUsernameEnvKey),
 		Password:           getEnvVar(JujuPasswordEnvKey),
 		SkipFailedDeletion: types.BoolValue(skipFailedDeletion),
 	}
 }
 
-func jujuProviderModelLiveDiscovery() (jujuProviderModel, bool) {
+// jujuProviderModelLiveDiscovery gets the controller config from the
+// controllers.yaml and accounts.yaml files of the local Juju client,
+// for the named controller or else the current one.
+func jujuProviderModelLiveDiscovery(controllerName string) (jujuProviderModel, error) {
 	data := jujuProviderModel{}
-	controllerConfig, cliNotExist := juju.GetLocalControllerConfig()
-	if cliNotExist {
-		return data, false
+	controllerConfig, err := juju.GetLocalControllerConfig(controllerName)
+	if err != nil {
+		return data, err
 	}
 
-	if ctrlAddrs, ok := controllerConfig[JujuControllerEnvKey]; ok && ctrlAddrs != "" {
-		data.ControllerAddrs = types.StringValue(ctrlAddrs)
+	data.ControllerName = types.StringValue(controllerConfig.ControllerName)
+	if len(controllerConfig.ControllerAddresses) > 0 {
+		data.ControllerAddrs = types.StringValue(strings.Join(controllerConfig.ControllerAddresses, ","))
 	}
-	if caCert, ok := controllerConfig[JujuCACertEnvKey]; ok && caCert != "" {
-		data.CACert = types.StringValue(caCert)
+	if controllerConfig.CACert != "" {
+		data.CACert = types.StringValue(controllerConfig.CACert)
 	}
-	if user, ok := controllerConfig[JujuUsernameEnvKey]; ok && user != "" {
-		data.UserName = types.StringValue(user)
+	if controllerConfig.Username != "" {
+		data.UserName = types.StringValue(controllerConfig.Username)
 	}
-	if password, ok := controllerConfig[JujuPasswordEnvKey]; ok && password != "" {
-		data.Password = types.StringValue(password)
+	if controllerConfig.Password != "" {
+		data.Password = types.StringValue(controllerConfig.Password)
 	}
-	return data, true
+	return data, nil
 }
 
 func getEnvVar(field string) types.String {
@@ -103,6 +112,8 @@ func getEnvVar(field string) types.String {
 
 // Ensure jujuProvider satisfies various provider interfaces.
 var _ provider.Provider = &jujuProvider{}
+var _ provider.ProviderWithEphemeralResources = &jujuProvider{}
+var _ provider.ProviderWithListResources = &jujuProvider{}
 
 // NewJujuProvider returns a framework style terraform provider.
 func NewJujuProvider(version string, waitForResources bool) provider.Provider {
@@ -126,6 +137,7 @@ type jujuProviderModel struct {
 	CACert          types.String `tfsdk:"ca_certificate"`
 	ClientID        types.String `tfsdk:"client_id"`
 	ClientSecret    types.String `tfsdk:"client_secret"`
+	ControllerName  types.String `tfsdk:"controller_name"`
 
 	SkipFailedDeletion types.Bool `tfsdk:"skip_failed_deletion"`
 }
@@ -138,12 +150,20 @@ func (j jujuProviderModel) loginViaClientCredentials() bool {
 	return j.ClientID.ValueString() != "" && j.ClientSecret.ValueString() != ""
 }
 
+// loginViaClientStore returns whether the account of the controller in
+// the local Juju client store has no password, in which case the login
+// uses the macaroons stored by the Juju client.
+func (j jujuProviderModel) loginViaClientStore() bool {
+	return j.ControllerName.ValueString() != "" && j.UserName.ValueString() != "" &&
+		j.Password.ValueString() == "" && !j.loginViaClientCredentials()
+}
+
 func (j jujuProviderModel) valid() bool {
 	validUserPass := j.loginViaUsername()
 	validClientCredentials := j.loginViaClientCredentials()
 
 	return j.ControllerAddrs.ValueString() != "" &&
-		(validUserPass || validClientCredentials) &&
+		(validUserPass || validClientCredentials || j.loginViaClientStore()) &&
 		!(validUserPass && validClientCredentials)
 }
 
@@ -172,6 +192,9 @@ func (j jujuProviderModel) merge(in jujuProviderModel, from string) (jujuProvide
 	if mergedModel.ClientSecret.ValueString() == "" {
 		mergedModel.ClientSecret = in.ClientSecret
 	}
+	if mergedModel.ControllerName.ValueString() == "" {
+		mergedModel.ControllerName = in.ControllerName
+	}
 	if mergedModel.valid() {
 		if in.UserName.ValueString() != "" {
 			diags.AddWarning(TwoSourcesAuthWarning,
@@ -253,6 +276,10 @@ func (p *jujuProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Description: fmt.Sprintf("If the controller was deployed with a self-signed certificate: This is the certificate to use for identification. This can also be set by the `%s` environment variable", JujuCACertEnvKey),
 				Optional:    true,
 			},
+			JujuControllerName: schema.StringAttribute{
+				Description: fmt.Sprintf("The name of the controller in the local Juju client store, i.e. the controllers.yaml and accounts.yaml files of the `JUJU_DATA` directory, to read the controller addresses, CA certificate and credentials from when they are not otherwise provided. If the account has no password, the login uses the macaroons stored by the Juju client. Defaults to the current controller of the Juju client. This can also be set by the `%s` environment variable.", JujuControllerNameEnvKey),
+				Optional:    true,
+			},
 			SkipFailedDeletion: schema.BoolAttribute{
 				Description: fmt.Sprintf("Whether to issue a warning instead of an error and continue if a resource deletion fails. This can also be set by the `%s` environment variable. Defaults to false.", SkipFailedDeletionEnvKey),
 				Optional:    true,
@@ -283,6 +310,7 @@ func (p *jujuProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		CACert:              data.CACert.ValueString(),
 		ClientID:            data.ClientID.ValueString(),
 		ClientSecret:        data.ClientSecret.ValueString(),
+		ControllerName:      data.ControllerName.ValueString(),
 	}
 	client, err := juju.NewClient(ctx, controllerConfig, p.waitForResources)
 	if err != nil {
@@ -309,6 +337,8 @@ func (p *jujuProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	resp.ResourceData = providerData
 	resp.DataSourceData = providerData
+	resp.EphemeralResourceData = providerData
+	resp.ListResourceData = providerData
 }
 
 // getJujuProviderModel a filled in jujuProviderModel if able. First check
@@ -352,17 +382,23 @@ func getJujuProviderModel(ctx context.Context, req provider.ConfigureRequest) (j
 
 	// Not all controller config contained in the plan, attempt
 	// to find it via live discovery.
-	liveData, cliAlive := jujuProviderModelLiveDiscovery()
+	controllerName := planEnvVarDataModel.ControllerName.ValueString()
+	liveData, err := jujuProviderModelLiveDiscovery(controllerName)
 	errMsgDataModel := planEnvVarDataModel
-	if cliAlive {
+	if err == nil {
 		livePlanEnvVarDataModel, livePlanEnvVarDataDiags := planEnvVarDataModel.merge(liveData, "live discovery")
 		diags.Append(livePlanEnvVarDataDiags...)
 		if livePlanEnvVarDataModel.valid() {
 			return livePlanEnvVarDataModel, diags
 		}
 		errMsgDataModel = livePlanEnvVarDataModel
+	} else if controllerName != "" {
+		diags.AddError("Controller not found",
+			fmt.Sprintf("Unable to read controller %q from the local Juju client store, got error: %s", controllerName, err))
+		return errMsgDataModel, diags
 	} else {
-		tflog.Debug(ctx, "Live discovery of juju controller failed. The Juju CLI could not be accessed.")
+		tflog.Debug(ctx, "Live discovery of juju controller failed. The local Juju client store could not be read.",
+			map[string]interface{}{"error": err.Error()})
 	}
 
 	// Validate controller config and return helpful error messages.
@@ -392,16 +428,22 @@ func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		func() resource.Resource { return NewAccessModelResource() },
 		func() resource.Resource { return NewAccessOfferResource() },
+		func() resource.Resource { return NewActionResource() },
 		func() resource.Resource { return NewApplicationResource() },
+		func() resource.Resource { return NewBundleResource() },
 		func() resource.Resource { return NewCredentialResource() },
 		func() resource.Resource { return NewIntegrationResource() },
 		func() resource.Resource { return NewKubernetesCloudResource() },
 		func() resource.Resource { return NewMachineResource() },
 		func() resource.Resource { return NewModelResource() },
+		func() resource.Resource { return NewModelDefaultsResource() },
 		func() resource.Resource { return NewOfferResource() },
 		func() resource.Resource { return NewSSHKeyResource() },
+		func() resource.Resource { return NewSAASResource() },
+		func() resource.Resource { return NewSpaceResource() },
 		func() resource.Resource { return NewUserResource() },
 		func() resource.Resource { return NewSecretResource() },
+		func() resource.Resource { return NewSecretBackendResource() },
 		func() resource.Resource { return NewAccessSecretResource() },
 		func() resource.Resource { return NewJAASAccessModelResource() },
 		func() resource.Resource { return NewJAASAccessCloudResource() },
@@ -409,8 +451,10 @@ func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource { return NewJAASAccessRoleResource() },
 		func() resource.Resource { return NewJAASAccessOfferResource() },
 		func() resource.Resource { return NewJAASAccessControllerResource() },
+		func() resource.Resource { return NewJAASAccessServiceAccountResource() },
 		func() resource.Resource { return NewJAASGroupResource() },
 		func() resource.Resource { return NewJAASRoleResource() },
+		func() resource.Resource { return NewJAASServiceAccountResource() },
 		func() resource.Resource { return NewStoragePoolResource() },
 	}
 }
@@ -425,11 +469,42 @@ func (p *jujuProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		func() datasource.DataSource { return NewApplicationDataSource() },
 		func() datasource.DataSource { return NewMachineDataSource() },
 		func() datasource.DataSource { return NewModelDataSource() },
+		func() datasource.DataSource { return NewModelBundleDataSource() },
 		func() datasource.DataSource { return NewOfferDataSource() },
 		func() datasource.DataSource { return NewSecretDataSource() },
 		func() datasource.DataSource { return NewJAASGroupDataSource() },
 		func() datasource.DataSource { return NewJAASRoleDataSource() },
 		func() datasource.DataSource { return NewStoragePoolDataSource() },
+		func() datasource.DataSource { return NewSubnetsDataSource() },
+	}
+}
+
+// EphemeralResources returns a slice of functions to instantiate each
+// EphemeralResource implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource
+// implementing the Metadata method. All ephemeral resources must have
+// unique names.
+func (p *jujuProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource { return NewActionResultEphemeralResource() },
+		func() ephemeral.EphemeralResource { return NewSecretEphemeralResource() },
+	}
+}
+
+// ListResources returns a slice of functions to instantiate each
+// ListResource implementation.
+//
+// The list resource type name is determined by the ListResource
+// implementing the Metadata method, and matches the type name of the
+// resource being listed.
+func (p *jujuProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		func() list.ListResource { return NewApplicationListResource() },
+		func() list.ListResource { return NewIntegrationListResource() },
+		func() list.ListResource { return NewMachineListResource() },
+		func() list.ListResource { return NewModelListResource() },
+		func() list.ListResource { return NewOfferListResource() },
 	}
 }
 
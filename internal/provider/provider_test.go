@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
 	"sync"
 	"testing"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -38,6 +40,10 @@ var frameworkProviderFactories map[string]func() (tfprotov6.ProviderServer, erro
 // acceptance testing but configures the provider to not wait for resources to be ready or destroyed.
 var frameworkProviderFactoriesNoResourceWait map[string]func() (tfprotov6.ProviderServer, error)
 
+// frameworkProviderFactoriesWithEcho adds the echo provider to the Framework
+// provider, to check the values of ephemeral resources in acceptance tests.
+var frameworkProviderFactoriesWithEcho map[string]func() (tfprotov6.ProviderServer, error)
+
 // Provider makes a separate provider available for tests.
 // Note that testAccPreCheck needs to invoked before use.
 var Provider provider.Provider
@@ -60,6 +66,10 @@ func init() {
 	frameworkProviderFactoriesNoResourceWait = map[string]func() (tfprotov6.ProviderServer, error){
 		"juju": providerserver.NewProtocol6WithError(NewJujuProvider("dev", false)),
 	}
+	frameworkProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+		"juju": providerserver.NewProtocol6WithError(NewJujuProvider("dev", true)),
+		"echo": echoprovider.NewProviderServer(),
+	}
 }
 
 // SkipJAAS should be called at the top of any tests that are not appropriate to
@@ -368,6 +378,7 @@ func newConfigureRequest(t *testing.T, conf jujuProviderModel) provider.Configur
 		JujuCACert:         types.StringType,
 		JujuClientID:       types.StringType,
 		JujuClientSecret:   types.StringType,
+		JujuControllerName: types.StringType,
 		SkipFailedDeletion: types.BoolType,
 	}
 
@@ -388,7 +399,7 @@ func TestFrameworkProviderSchema(t *testing.T) {
 	resp := provider.SchemaResponse{}
 	jujuProvider.Schema(context.Background(), req, &resp)
 	assert.Equal(t, resp.Diagnostics.HasError(), false)
-	assert.Len(t, resp.Schema.Attributes, 7)
+	assert.Len(t, resp.Schema.Attributes, 8)
 }
 
 // TestGetJujuProviderModel tests the getJujuProviderModel function.
@@ -499,6 +510,47 @@ func TestGetJujuProviderModel(t *testing.T) {
 				SkipFailedDeletion: types.BoolValue(false),
 			},
 		},
+		{
+			name: "LiveDiscoveryNamedController",
+			plan: jujuProviderModel{
+				ControllerName: types.StringValue("ctrl-b"),
+			},
+			setEnv:  setTestJujuData,
+			wantErr: false,
+			wantValues: jujuProviderModel{
+				ControllerAddrs:    types.StringValue("10.0.0.2:17070,10.0.0.3:17070"),
+				UserName:           types.StringValue("admin"),
+				Password:           types.StringValue("pass-b"),
+				CACert:             types.StringValue("cert-b"),
+				ControllerName:     types.StringValue("ctrl-b"),
+				SkipFailedDeletion: types.BoolValue(false),
+			},
+		},
+		{
+			name: "LiveDiscoveryMacaroonAccount",
+			plan: jujuProviderModel{},
+			setEnv: func(t *testing.T) {
+				setTestJujuData(t)
+				t.Setenv(JujuControllerNameEnvKey, "ctrl-a")
+			},
+			wantErr: false,
+			wantValues: jujuProviderModel{
+				ControllerAddrs:    types.StringValue("10.0.0.1:17070"),
+				UserName:           types.StringValue("alice@external"),
+				CACert:             types.StringValue("cert-a"),
+				ControllerName:     types.StringValue("ctrl-a"),
+				SkipFailedDeletion: types.BoolValue(false),
+			},
+		},
+		{
+			name: "LiveDiscoveryUnknownController",
+			plan: jujuProviderModel{
+				ControllerName: types.StringValue("ctrl-c"),
+			},
+			setEnv:         setTestJujuData,
+			wantErr:        true,
+			wantErrSummary: "Controller not found",
+		},
 	}
 
 	for _, tt := range tests {
@@ -528,6 +580,39 @@ func TestGetJujuProviderModel(t *testing.T) {
 	}
 }
 
+// setTestJujuData points JUJU_DATA at a local Juju client store with
+// two controllers, the first of which uses a macaroon-based account.
+func setTestJujuData(t *testing.T) {
+	dir := t.TempDir()
+	controllers := `controllers:
+  ctrl-a:
+    uuid: 4ad4d4b8-0a42-4d47-8f2b-5a6c1a2f8c01
+    api-endpoints: ['10.0.0.1:17070']
+    ca-cert: cert-a
+    cloud: lxd
+  ctrl-b:
+    uuid: 4ad4d4b8-0a42-4d47-8f2b-5a6c1a2f8c02
+    api-endpoints: ['10.0.0.2:17070', '10.0.0.3:17070']
+    ca-cert: cert-b
+    cloud: lxd
+current-controller: ctrl-a
+`
+	accounts := `controllers:
+  ctrl-a:
+    user: alice@external
+  ctrl-b:
+    user: admin
+    password: pass-b
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "controllers.yaml"), []byte(controllers), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "accounts.yaml"), []byte(accounts), 0600))
+	t.Setenv("JUJU_DATA", dir)
+	for _, key := range []string{JujuControllerEnvKey, JujuUsernameEnvKey, JujuPasswordEnvKey, JujuCACertEnvKey,
+		JujuClientIDEnvKey, JujuClientSecretEnvKey, JujuControllerNameEnvKey} {
+		t.Setenv(key, "")
+	}
+}
+
 func expectedResourceOwner() string {
 	// Only 1 field is expected to be populated.
 	username := os.Getenv(JujuUsernameEnvKey)
@@ -0,0 +1,482 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/wait"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &actionResource{}
+var _ resource.ResourceWithConfigure = &actionResource{}
+
+// actionResultsType is the type of the results of an action, keyed
+// by the name of the unit which ran the action.
+var actionResultsType = types.MapType{ElemType: types.MapType{ElemType: types.StringType}}
+
+// NewActionResource returns a new instance of the action resource,
+// which runs a charm action when it is created.
+func NewActionResource() resource.Resource {
+	return &actionResource{}
+}
+
+type actionResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for actions.
+	subCtx context.Context
+}
+
+type actionResourceModel struct {
+	ModelUUID        types.String   `tfsdk:"model_uuid"`
+	Application      types.String   `tfsdk:"application"`
+	Units            types.Set      `tfsdk:"units"`
+	Action           types.String   `tfsdk:"action"`
+	Parameters       types.Dynamic  `tfsdk:"parameters"`
+	Triggers         types.Map      `tfsdk:"triggers"`
+	Sensitive        types.Bool     `tfsdk:"sensitive"`
+	OperationID      types.String   `tfsdk:"operation_id"`
+	Results          types.Map      `tfsdk:"results"`
+	SensitiveResults types.Map      `tfsdk:"sensitive_results"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *actionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action"
+}
+
+func (r *actionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that runs a charm action on the leader or on specific units of an application, " +
+			"and waits for it to complete. The action runs when the resource is created, and runs again " +
+			"whenever any of its arguments or triggers change. Destroying the resource only removes it " +
+			"from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The UUID of the model of the application.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+			},
+			"application": schema.StringAttribute{
+				Description: "The name of the application to run the action on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidApplication, "must be a valid application name"),
+				},
+			},
+			"units": schema.SetAttribute{
+				Description: "The names of the units to run the action on, e.g. `postgresql/0`. " +
+					"The action runs on the leader unit of the application if not set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "The name of the action to run, as defined by the charm.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.DynamicAttribute{
+				Description: "The parameters of the action, as an object. Values keep their type, " +
+					"so numbers, booleans, lists and nested objects can be passed to the action.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values which cause the action to run again when changed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sensitive": schema.BoolAttribute{
+				Description: "Store the results of the action in `sensitive_results` rather than in `results`, " +
+					"so that they are not displayed in the Terraform output. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation_id": schema.StringAttribute{
+				Description: "The ID of the operation which ran the action.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"results": schema.MapAttribute{
+				Description: "The results of the action, keyed by the name of the unit which ran it. " +
+					"Nested results are flattened, with their keys joined by a dot. Not set if `sensitive` is true.",
+				ElementType: actionResultsType.ElemType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sensitive_results": schema.MapAttribute{
+				Description: "The results of the action, in the same format as `results`, when `sensitive` is true.",
+				ElementType: actionResultsType.ElemType,
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: timeoutDescription("the action to complete", defaultCreateTimeout),
+			}),
+		},
+	}
+}
+
+func (r *actionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.Client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceAction)
+}
+
+// Create runs the action and waits for it to complete. If the action
+// fails, the resource is not created so that the action runs again on
+// the next apply.
+func (r *actionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "action", "create")
+		return
+	}
+
+	var plan actionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var units []string
+	resp.Diagnostics.Append(plan.Units.ElementsAs(ctx, &units, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parameters, err := actionParameters(plan.Parameters)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid Parameters", err.Error())
+		return
+	}
+
+	modelUUID := plan.ModelUUID.ValueString()
+	runResp, err := r.client.Actions.RunAction(&juju.RunActionInput{
+		ModelUUID:       modelUUID,
+		ApplicationName: plan.Application.ValueString(),
+		Units:           units,
+		ActionName:      plan.Action.ValueString(),
+		Parameters:      parameters,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run action, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("running action %q", plan.Action.ValueString()), map[string]interface{}{
+		"operation": runResp.OperationID,
+	})
+
+	operation, err := waitForOperation(ctx, r.client, modelUUID, runResp.OperationID, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Action %q did not complete, got error: %s", plan.Action.ValueString(), err))
+		return
+	}
+
+	results, dErr := types.MapValueFrom(ctx, actionResultsType.ElemType, actionResults(operation.Tasks))
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	plan.Results = types.MapNull(actionResultsType.ElemType)
+	plan.SensitiveResults = types.MapNull(actionResultsType.ElemType)
+	if plan.Sensitive.ValueBool() {
+		plan.SensitiveResults = results
+	} else {
+		plan.Results = results
+	}
+	plan.OperationID = types.StringValue(runResp.OperationID)
+	plan.ID = types.StringValue(newIDForAction(modelUUID, runResp.OperationID))
+	r.trace("Created", map[string]interface{}{"id": plan.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is. An action is a one-off operation, the
+// results stored in the state are those of the run which created it.
+func (r *actionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state actionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only happens when the timeouts change, as changing any other
+// argument runs the action again by replacing the resource.
+func (r *actionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan actionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the action from the Terraform state only, an action
+// which has run cannot be undone.
+func (r *actionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state actionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace("Deleted", map[string]interface{}{"id": state.ID.ValueString()})
+}
+
+// waitForOperation waits for the operation to be done and returns it,
+// or fails if the operation did not complete.
+func waitForOperation(ctx context.Context, client *juju.Client, modelUUID, operationID string, timeout time.Duration) (*juju.ReadOperationResponse, error) {
+	return wait.WaitFor(
+		wait.WaitForCfg[*juju.ReadOperationInput, *juju.ReadOperationResponse]{
+			Context: ctx,
+			GetData: client.Actions.ReadOperation,
+			Input: &juju.ReadOperationInput{
+				ModelUUID:   modelUUID,
+				OperationID: operationID,
+			},
+			DataAssertions: []wait.Assert[*juju.ReadOperationResponse]{assertOperationCompleted},
+			NonFatalErrors: []error{juju.ConnectionRefusedError, juju.RetryReadError},
+			RetryConf: &wait.RetryConf{
+				MaxDuration: timeout,
+				MaxDelay:    10 * time.Second,
+			},
+		},
+	)
+}
+
+// assertOperationCompleted retries until the operation is done, then
+// fails with the messages of the tasks if it did not complete.
+func assertOperationCompleted(operation *juju.ReadOperationResponse) error {
+	switch operation.Status {
+	case juju.ActionStatusPending, juju.ActionStatusRunning, juju.ActionStatusAborting:
+		return juju.NewRetryReadError(fmt.Sprintf("operation is %s", operation.Status))
+	case juju.ActionStatusCompleted:
+		return nil
+	}
+	var failures []string
+	for _, task := range operation.Tasks {
+		if task.Status != juju.ActionStatusCompleted {
+			failures = append(failures, fmt.Sprintf("unit %s %s: %s", task.Unit, task.Status, task.Message))
+		}
+	}
+	return errors.Errorf("operation %s: %s", operation.Status, strings.Join(failures, ", "))
+}
+
+// actionParameters converts the parameters of the action to the values
+// expected by the Juju API.
+func actionParameters(parameters types.Dynamic) (map[string]interface{}, error) {
+	if parameters.IsNull() || parameters.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+	value, err := attrValueToInterface(parameters.UnderlyingValue())
+	if err != nil {
+		return nil, err
+	}
+	result, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("parameters must be an object, got %s", parameters.UnderlyingValue().Type(context.Background()))
+	}
+	return result, nil
+}
+
+// attrValueToInterface converts a Terraform value to its Go equivalent.
+func attrValueToInterface(value attr.Value) (interface{}, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, errors.New("value is not known")
+	}
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return attrValueToInterface(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.NumberValue:
+		number := v.ValueBigFloat()
+		if number.IsInt() {
+			if i, accuracy := number.Int64(); accuracy == big.Exact {
+				return i, nil
+			}
+		}
+		f, _ := number.Float64()
+		return f, nil
+	case basetypes.ListValue:
+		return attrValuesToInterface(v.Elements())
+	case basetypes.SetValue:
+		return attrValuesToInterface(v.Elements())
+	case basetypes.TupleValue:
+		return attrValuesToInterface(v.Elements())
+	case basetypes.MapValue:
+		return attrMapToInterface(v.Elements())
+	case basetypes.ObjectValue:
+		return attrMapToInterface(v.Attributes())
+	}
+	return nil, errors.Errorf("unsupported value type %T", value)
+}
+
+func attrValuesToInterface(values []attr.Value) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		converted, err := attrValueToInterface(value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
+
+func attrMapToInterface(values map[string]attr.Value) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		converted, err := attrValueToInterface(value)
+		if err != nil {
+			return nil, errors.Annotatef(err, "%q", key)
+		}
+		result[key] = converted
+	}
+	return result, nil
+}
+
+// actionResults returns the output of each task keyed by unit, with
+// nested output flattened.
+func actionResults(tasks []juju.ActionTask) map[string]map[string]string {
+	results := make(map[string]map[string]string, len(tasks))
+	for _, task := range tasks {
+		output := make(map[string]string)
+		flattenActionOutput("", task.Output, output)
+		results[task.Unit] = output
+	}
+	return results
+}
+
+// flattenActionOutput flattens the nested output of an action into
+// result, joining nested keys with a dot. Values other than strings
+// are stored as JSON.
+func flattenActionOutput(prefix string, output map[string]interface{}, result map[string]string) {
+	for key, value := range output {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch v := value.(type) {
+		case string:
+			result[key] = v
+		case map[string]interface{}:
+			flattenActionOutput(key, v, result)
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				encoded = []byte(fmt.Sprint(v))
+			}
+			result[key] = string(encoded)
+		}
+	}
+}
+
+func newIDForAction(modelUUID, operationID string) string {
+	return fmt.Sprintf("%s:%s", modelUUID, operationID)
+}
+
+func (r *actionResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceAction, msg, additionalFields...)
+}
@@ -0,0 +1,185 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func TestActionParameters(t *testing.T) {
+	parameters := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"username": types.StringType,
+			"length":   types.NumberType,
+			"ratio":    types.NumberType,
+			"force":    types.BoolType,
+			"tags":     types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}},
+			"nested":   types.ObjectType{AttrTypes: map[string]attr.Type{"key": types.StringType}},
+		},
+		map[string]attr.Value{
+			"username": types.StringValue("operator"),
+			"length":   types.NumberValue(big.NewFloat(3)),
+			"ratio":    types.NumberValue(big.NewFloat(0.5)),
+			"force":    types.BoolValue(true),
+			"tags": types.TupleValueMust(
+				[]attr.Type{types.StringType, types.StringType},
+				[]attr.Value{types.StringValue("a"), types.StringValue("b")},
+			),
+			"nested": types.ObjectValueMust(
+				map[string]attr.Type{"key": types.StringType},
+				map[string]attr.Value{"key": types.StringValue("value")},
+			),
+		},
+	))
+
+	result, err := actionParameters(parameters)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"username": "operator",
+		"length":   int64(3),
+		"ratio":    0.5,
+		"force":    true,
+		"tags":     []interface{}{"a", "b"},
+		"nested":   map[string]interface{}{"key": "value"},
+	}, result)
+
+	result, err = actionParameters(types.DynamicNull())
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	_, err = actionParameters(types.DynamicValue(types.StringValue("operator")))
+	assert.ErrorContains(t, err, "parameters must be an object")
+}
+
+func TestActionResults(t *testing.T) {
+	results := actionResults([]juju.ActionTask{{
+		Unit: "postgresql/0",
+		Output: map[string]interface{}{
+			"password":    "secret",
+			"return-code": 0,
+			"user": map[string]interface{}{
+				"name":  "operator",
+				"roles": []interface{}{"admin"},
+			},
+		},
+	}, {
+		Unit: "postgresql/1",
+	}})
+	assert.Equal(t, map[string]map[string]string{
+		"postgresql/0": {
+			"password":    "secret",
+			"return-code": "0",
+			"user.name":   "operator",
+			"user.roles":  `["admin"]`,
+		},
+		"postgresql/1": {},
+	}, results)
+}
+
+func TestAssertOperationCompleted(t *testing.T) {
+	err := assertOperationCompleted(&juju.ReadOperationResponse{Status: juju.ActionStatusRunning})
+	assert.ErrorIs(t, err, juju.RetryReadError)
+
+	err = assertOperationCompleted(&juju.ReadOperationResponse{Status: juju.ActionStatusCompleted})
+	assert.NoError(t, err)
+
+	err = assertOperationCompleted(&juju.ReadOperationResponse{
+		Status: "failed",
+		Tasks: []juju.ActionTask{{
+			Unit:   "postgresql/0",
+			Status: juju.ActionStatusCompleted,
+		}, {
+			Unit:    "postgresql/1",
+			Status:  "failed",
+			Message: "database is not ready",
+		}},
+	})
+	assert.EqualError(t, err, "operation failed: unit postgresql/1 failed: database is not ready")
+	assert.NotErrorIs(t, err, juju.RetryReadError)
+}
+
+func TestAcc_ResourceAction(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-action")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceAction(modelName, "not-an-action", "1", false),
+				ExpectError: regexp.MustCompile(`action "not-an-action" of application "test-app" not found`),
+			},
+			{
+				Config: testAccResourceAction(modelName, "fortune", "1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("juju_action.this", "operation_id"),
+					resource.TestCheckResourceAttr("juju_action.this", "results.%", "1"),
+					resource.TestCheckResourceAttrSet("juju_action.this", "results.test-app/0.fortune"),
+					resource.TestCheckNoResourceAttr("juju_action.this", "sensitive_results.%"),
+				),
+			},
+			{
+				Config: testAccResourceAction(modelName, "fortune", "2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("juju_action.this", "results.%"),
+					resource.TestCheckResourceAttr("juju_action.this", "sensitive_results.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceAction(modelName, action, trigger string, sensitive bool) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model_uuid = juju_model.this.uuid
+  name       = "test-app"
+
+  charm {
+    name = "juju-qa-test"
+    base = "ubuntu@22.04"
+  }
+
+  wait_for {
+    workload_status = ["active", "unknown"]
+    agent_status    = "idle"
+  }
+}
+
+resource "juju_action" "this" {
+  model_uuid  = juju_model.this.uuid
+  application = juju_application.this.name
+  action      = %q
+
+  parameters = {
+    length = "short"
+  }
+
+  triggers = {
+    run = %q
+  }
+
+  sensitive = %t
+}
+`, modelName, action, trigger, sensitive)
+}
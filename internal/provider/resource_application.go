@@ -9,12 +9,17 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -38,6 +43,8 @@ import (
 
 const (
 	CharmKey            = "charm"
+	CharmHashKey        = "hash"
+	CharmPathKey        = "path"
 	CidrsKey            = "cidrs"
 	ConfigKey           = "config"
 	EndpointBindingsKey = "endpoint_bindings"
@@ -48,6 +55,7 @@ const (
 	SpacesKey           = "spaces"
 	StorageKey          = "storage"
 	UnitsKey            = "units"
+	WaitForKey          = "wait_for"
 
 	imageRegistriesMarkdownDescription = `
 	OCI image registry credentials for OCI images specified in the charm resources. The map key is the registry URL.
@@ -76,6 +84,8 @@ var _ resource.Resource = &applicationResource{}
 var _ resource.ResourceWithConfigure = &applicationResource{}
 var _ resource.ResourceWithImportState = &applicationResource{}
 var _ resource.ResourceWithUpgradeState = &applicationResource{}
+var _ resource.ResourceWithIdentity = &applicationResource{}
+var _ list.ListResourceWithConfigure = &applicationResource{}
 
 // NewApplicationResource returns a new instance of the application resource responsible
 // for managing Juju applications, including their configuration, charm, constraints, and
@@ -84,6 +94,12 @@ func NewApplicationResource() resource.Resource {
 	return &applicationResource{}
 }
 
+// NewApplicationListResource returns a new instance of the application list resource,
+// which lists the applications of a model.
+func NewApplicationListResource() list.ListResource {
+	return &applicationResource{}
+}
+
 type applicationResource struct {
 	client         *juju.Client
 	providerConfig juju.Config
@@ -128,8 +144,11 @@ type applicationResourceModelV0 struct {
 // tfsdk must match user resource schema attribute names.
 type applicationResourceModelV1 struct {
 	applicationResourceModel
+	destroyOptionsModel
 	RegistryCredentials map[string]registryDetails `tfsdk:"registry_credentials"`
 	ModelUUID           types.String               `tfsdk:"model_uuid"`
+	WaitFor             types.List                 `tfsdk:"wait_for"`
+	Timeouts            timeouts.Value             `tfsdk:"timeouts"`
 }
 
 func (r *applicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -160,7 +179,7 @@ func (r *applicationResource) Configure(ctx context.Context, req resource.Config
 	r.subCtx = tflog.NewSubsystem(ctx, LogResourceApplication)
 }
 
-func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *applicationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A resource that represents a single Juju application deployment from a charm. Deployment of bundles" +
 			" is not supported.",
@@ -345,17 +364,43 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 		},
 		Blocks: map[string]schema.Block{
 			CharmKey: schema.ListNestedBlock{
-				Description: "The charm installed from Charmhub.",
+				Description: "The charm installed from Charmhub or from a local charm archive or directory.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
-							Required: true,
+							Optional: true,
+							Computed: true,
 							Description: "The name of the charm to be deployed.  Changing this value will cause" +
-								" the application to be destroyed and recreated by terraform.",
+								" the application to be destroyed and recreated by terraform. Exactly one of name or path must be specified.",
 							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
 								stringplanmodifier.RequiresReplaceIfConfigured(),
 							},
 						},
+						CharmPathKey: schema.StringAttribute{
+							Description: "The path to a local charm archive (.charm file) or charm directory to deploy. " +
+								"The charm is uploaded to the controller and the application is refreshed whenever the " +
+								"content of the charm changes. Switching between a local charm and a Charmhub charm will " +
+								"cause the application to be destroyed and recreated by terraform.",
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplaceIf(localCharmPathRequiresReplaceIf, "", ""),
+							},
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("name")),
+								stringvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("channel"),
+									path.MatchRelative().AtParent().AtName("revision"),
+								),
+							},
+						},
+						CharmHashKey: schema.StringAttribute{
+							Description: "The sha256 hash of the local charm, used to detect when the charm at path has changed.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								localCharmHashModifier{},
+							},
+						},
 						"channel": schema.StringAttribute{
 							Description: "The channel to use when deploying a charm. Specified as \\<track>/\\<risk>/\\<branch>.",
 							Optional:    true,
@@ -373,6 +418,7 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 							Computed:    true,
 							PlanModifiers: []planmodifier.Int64{
 								int64planmodifier.UseStateForUnknown(),
+								localCharmRevisionModifier{},
 							},
 						},
 						BaseKey: schema.StringAttribute{
@@ -416,8 +462,58 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			WaitForKey: schema.ListNestedBlock{
+				Description: "Wait for the units of the application to reach the given status after the application " +
+					"is created or updated. Waiting fails as soon as a unit reports a blocked or error workload " +
+					"status which was not requested. The wait is bounded by the create and update timeouts.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"workload_status": schema.SetAttribute{
+							Description: "The workload statuses in which a unit is considered ready. Defaults to [\"active\"].",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(
+									"active", "blocked", "error", "maintenance", "unknown", "waiting",
+								)),
+							},
+						},
+						"agent_status": schema.StringAttribute{
+							Description: "The agent status a unit must have to be considered ready, e.g. idle.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("executing", "idle"),
+							},
+						},
+						"min_ready_units": schema.Int64Attribute{
+							Description: "The minimum number of units which must be ready. Defaults to all the units of the application.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: timeoutDescription("the application to be deployed and, with `wait_for`, its units to reach the expected status", defaultCreateTimeout),
+				Read:              true,
+				ReadDescription:   timeoutDescription("the application to be read", defaultReadTimeout),
+				Update:            true,
+				UpdateDescription: timeoutDescription("the application to be updated and, with `wait_for`, its units to reach the expected status", defaultUpdateTimeout),
+				Delete:            true,
+				DeleteDescription: timeoutDescription("the application to be removed", defaultDeleteTimeout),
+			}),
 		},
 	}
+	for name, attribute := range destroyOptionsAttributes("application") {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 // nestedCharm represents the single element of the charm ListNestedBlock
@@ -427,6 +523,17 @@ type nestedCharm struct {
 	Channel  types.String `tfsdk:"channel"`
 	Revision types.Int64  `tfsdk:"revision"`
 	Base     types.String `tfsdk:"base"`
+	Path     types.String `tfsdk:"path"`
+	Hash     types.String `tfsdk:"hash"`
+}
+
+// nestedCharmV0 represents the single element of the charm ListNestedBlock
+// of the version 0 application resource, before local charms were supported.
+type nestedCharmV0 struct {
+	Name     types.String `tfsdk:"name"`
+	Channel  types.String `tfsdk:"channel"`
+	Revision types.Int64  `tfsdk:"revision"`
+	Base     types.String `tfsdk:"base"`
 }
 
 // nestedExpose represents the single element of expose ListNestedBlock
@@ -469,6 +576,14 @@ func parseNestedExpose(value map[string]interface{}) nestedExpose {
 	return resp
 }
 
+// nestedWaitFor represents the single element of the wait_for
+// ListNestedBlock of the application resource schema
+type nestedWaitFor struct {
+	WorkloadStatus types.Set    `tfsdk:"workload_status"`
+	AgentStatus    types.String `tfsdk:"agent_status"`
+	MinReadyUnits  types.Int64  `tfsdk:"min_ready_units"`
+}
+
 // nestedEndpointBinding represents the single element of endpoint_bindings
 // ListNestedAttribute
 type nestedEndpointBinding struct {
@@ -615,8 +730,16 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	modelUUID := plan.ModelUUID.ValueString()
-	createResp, err := r.client.Applications.CreateApplication(ctx,
+	createResp, err := r.client.Applications.CreateApplication(createCtx,
 		&juju.CreateApplicationInput{
 			ApplicationName:    plan.ApplicationName.ValueString(),
 			ModelUUID:          modelUUID,
@@ -624,6 +747,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 			CharmChannel:       channel,
 			CharmRevision:      revision,
 			CharmBase:          planCharm.Base.ValueString(),
+			CharmPath:          planCharm.Path.ValueString(),
 			Units:              unitCount,
 			Config:             config,
 			Constraints:        parsedConstraints,
@@ -640,6 +764,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	var partialApp juju.ApplicationPartiallyCreatedError
 	if errors.As(err, &partialApp) {
 		plan.ID = types.StringValue(newAppID(plan.ModelUUID.ValueString(), partialApp.AppName))
+		resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Application partially created then failed, got error: %s", err))
 		return
@@ -650,7 +775,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	r.trace(fmt.Sprintf("create application resource %q", createResp.AppName))
-	readResp, err := r.client.Applications.ReadApplicationWithRetryOnNotFound(ctx, &juju.ReadApplicationInput{
+	readResp, err := r.client.Applications.ReadApplicationWithRetryOnNotFound(createCtx, &juju.ReadApplicationInput{
 		ModelUUID: modelUUID,
 		AppName:   createResp.AppName,
 	})
@@ -679,6 +804,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	plan.ApplicationName = types.StringValue(createResp.AppName)
 	plan.ModelType = types.StringValue(readResp.ModelType)
+	planCharm.Name = types.StringValue(readResp.Name)
 	planCharm.Revision = types.Int64Value(int64(readResp.Revision))
 	planCharm.Base = types.StringValue(readResp.Base)
 	planCharm.Channel = types.StringValue(readResp.Channel)
@@ -714,7 +840,18 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	plan.ID = types.StringValue(newAppID(plan.ModelUUID.ValueString(), createResp.AppName))
 	r.trace("Created", applicationResourceModelForLogging(ctx, &plan))
 
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The application is saved to state before waiting, so that it is
+	// marked as tainted if its units fail to reach the expected status.
+	expectedUnits := 0
+	if readResp.Principal {
+		expectedUnits = int(plan.UnitCount.ValueInt64())
+	}
+	resp.Diagnostics.Append(r.waitForUnitStatus(createCtx, modelUUID, createResp.AppName, plan.WaitFor, expectedUnits, createTimeout)...)
 }
 
 // createCharmResources processes the resources map specified
@@ -809,6 +946,7 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, state.ID)...)
 
 	r.trace("Read", map[string]interface{}{
 		"ID": state.ID.ValueString(),
@@ -820,9 +958,20 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	response, err := r.client.Applications.ReadApplication(&juju.ReadApplicationInput{
-		ModelUUID: modelUUID,
-		AppName:   appName,
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response *juju.ReadApplicationResponse
+	err := retryOnConnectionRefused(ctx, readTimeout, func() error {
+		var err error
+		response, err = r.client.Applications.ReadApplication(&juju.ReadApplicationInput{
+			ModelUUID: modelUUID,
+			AppName:   appName,
+		})
+		return err
 	})
 	if err != nil {
 		resp.Diagnostics.Append(handleApplicationNotFoundError(ctx, err, &resp.State)...)
@@ -867,6 +1016,19 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		Channel:  types.StringValue(response.Channel),
 		Revision: types.Int64Value(int64(response.Revision)),
 		Base:     types.StringValue(response.Base),
+		Path:     types.StringNull(),
+		Hash:     types.StringNull(),
+	}
+	// The path and hash of a local charm are only known to terraform,
+	// keep them from the prior state.
+	var stateCharms []nestedCharm
+	resp.Diagnostics.Append(state.Charm.ElementsAs(ctx, &stateCharms, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(stateCharms) > 0 {
+		dataCharm.Path = stateCharms[0].Path
+		dataCharm.Hash = stateCharms[0].Hash
 	}
 	charmType := req.State.Schema.GetBlocks()[CharmKey].(schema.ListNestedBlock).NestedObject.Type()
 	state.Charm, dErr = types.ListValueFrom(ctx, charmType, []nestedCharm{dataCharm})
@@ -1017,6 +1179,12 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	r.trace("Proposed update", applicationResourceModelForLogging(ctx, &plan))
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	r.trace("Current state", applicationResourceModelForLogging(ctx, &state))
 
 	updateApplicationInput := juju.UpdateApplicationInput{
@@ -1048,19 +1216,32 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		planCharm := planCharms[0]
 		stateCharm := stateCharms[0]
-		if !planCharm.Channel.Equal(stateCharm.Channel) {
-			updateApplicationInput.Channel = planCharm.Channel.ValueString()
+		if !planCharm.Path.IsNull() {
+			// Local charms have no channel to refresh from and their
+			// revision is assigned on upload. The new charm is uploaded
+			// whenever its content changes.
+			if !planCharm.Hash.Equal(stateCharm.Hash) || !planCharm.Path.Equal(stateCharm.Path) {
+				updateApplicationInput.CharmPath = planCharm.Path.ValueString()
+			}
 		} else {
-			updateApplicationInput.Channel = stateCharm.Channel.ValueString()
-		}
+			if !planCharm.Channel.Equal(stateCharm.Channel) {
+				updateApplicationInput.Channel = planCharm.Channel.ValueString()
+			} else {
+				updateApplicationInput.Channel = stateCharm.Channel.ValueString()
+			}
 
-		if !planCharm.Revision.Equal(stateCharm.Revision) {
-			updateApplicationInput.Revision = intPtr(planCharm.Revision)
-		} else {
-			updateApplicationInput.Revision = intPtr(stateCharm.Revision)
+			if !planCharm.Revision.Equal(stateCharm.Revision) {
+				updateApplicationInput.Revision = intPtr(planCharm.Revision)
+			} else {
+				updateApplicationInput.Revision = intPtr(stateCharm.Revision)
+			}
 		}
 
 		if !planCharm.Base.Equal(stateCharm.Base) {
+			if !planCharm.Path.IsNull() {
+				resp.Diagnostics.AddError("Unsupported", "unable to update the base of an application deployed from a local charm")
+				return
+			}
 			updateApplicationInput.Base = planCharm.Base.ValueString()
 		}
 	}
@@ -1224,8 +1405,12 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 				ModelUUID: updateApplicationInput.ModelUUID,
 				AppName:   updateApplicationInput.AppName,
 			},
+			Changes:        func() <-chan struct{} { return r.client.ModelChanged(updateApplicationInput.ModelUUID) },
 			DataAssertions: asserts,
 			NonFatalErrors: []error{juju.ConnectionRefusedError, juju.RetryReadError, juju.ApplicationNotFoundError, juju.StorageNotFoundError},
+			RetryConf: &wait.RetryConf{
+				MaxDuration: updateTimeout,
+			},
 		},
 	)
 	if err != nil {
@@ -1245,7 +1430,27 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// A refreshed local charm is assigned a new revision by the controller.
+	if updateApplicationInput.CharmPath != "" {
+		var planCharms []nestedCharm
+		resp.Diagnostics.Append(plan.Charm.ElementsAs(ctx, &planCharms, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		planCharm := planCharms[0]
+		planCharm.Name = types.StringValue(readResp.Name)
+		planCharm.Revision = types.Int64Value(int64(readResp.Revision))
+		planCharm.Channel = types.StringValue(readResp.Channel)
+		charmType := req.Config.Schema.GetBlocks()[CharmKey].(schema.ListNestedBlock).NestedObject.Type()
+		plan.Charm, dErr = types.ListValueFrom(ctx, charmType, []nestedCharm{planCharm})
+		if dErr.HasError() {
+			resp.Diagnostics.Append(dErr...)
+			return
+		}
+	}
+
 	if updateApplicationInput.Channel != "" ||
+		updateApplicationInput.CharmPath != "" ||
 		updateApplicationInput.Revision != nil ||
 		updateApplicationInput.Units != nil ||
 		updateApplicationInput.Base != "" {
@@ -1280,7 +1485,16 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.ModelType = state.ModelType
 	plan.ID = types.StringValue(newAppID(plan.ModelUUID.ValueString(), plan.ApplicationName.ValueString()))
 	r.trace("Updated", applicationResourceModelForLogging(ctx, &plan))
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	expectedUnits := 0
+	if readResp.Principal {
+		expectedUnits = int(plan.UnitCount.ValueInt64())
+	}
+	resp.Diagnostics.Append(r.waitForUnitStatus(ctx, updateApplicationInput.ModelUUID, updateApplicationInput.AppName, plan.WaitFor, expectedUnits, updateTimeout)...)
 }
 
 // updateStorage compares the plan storage directives to the
@@ -1440,15 +1654,29 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	if dErr.HasError() {
 		resp.Diagnostics.Append(dErr...)
 	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destroyStorage, force, maxWait, err := state.destroyArgs()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(MaxWaitKey), "Invalid Max Wait", err.Error())
+		return
+	}
 
 	if err := r.client.Applications.DestroyApplication(&juju.DestroyApplicationInput{
 		ApplicationName: appName,
 		ModelUUID:       modelUUID,
+		DestroyStorage:  destroyStorage,
+		Force:           force,
+		MaxWait:         maxWait,
 	}); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete application, got error: %s", err))
 	}
 
-	err := wait.WaitForError(
+	err = wait.WaitForError(
 		wait.WaitForErrorCfg[*juju.ReadApplicationInput, *juju.ReadApplicationResponse]{
 			Context: ctx,
 			GetData: r.client.Applications.ReadApplication,
@@ -1456,8 +1684,12 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 				ModelUUID: modelUUID,
 				AppName:   appName,
 			},
+			Changes:        func() <-chan struct{} { return r.client.ModelChanged(modelUUID) },
 			ExpectedErr:    juju.ApplicationNotFoundError,
 			RetryAllErrors: true,
+			RetryConf: &wait.RetryConf{
+				MaxDuration: deleteTimeout,
+			},
 		},
 	)
 	if err != nil {
@@ -1504,8 +1736,36 @@ func (o *applicationResource) UpgradeState(ctx context.Context) map[int64]resour
 				// appV0.ID is embedded in the applicationResourceModel struct.
 				appV0.ID = types.StringValue(newID)
 
+				// The charm block of version 0 has no path nor hash attributes.
+				var charmsV0 []nestedCharmV0
+				resp.Diagnostics.Append(appV0.Charm.ElementsAs(ctx, &charmsV0, false)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				charms := make([]nestedCharm, 0, len(charmsV0))
+				for _, charmV0 := range charmsV0 {
+					charms = append(charms, nestedCharm{
+						Name:     charmV0.Name,
+						Channel:  charmV0.Channel,
+						Revision: charmV0.Revision,
+						Base:     charmV0.Base,
+						Path:     types.StringNull(),
+						Hash:     types.StringNull(),
+					})
+				}
+				charmType := resp.State.Schema.GetBlocks()[CharmKey].(schema.ListNestedBlock).NestedObject.Type()
+				var dErr diag.Diagnostics
+				appV0.Charm, dErr = types.ListValueFrom(ctx, charmType, charms)
+				if dErr.HasError() {
+					resp.Diagnostics.Append(dErr...)
+					return
+				}
+
+				waitForType := resp.State.Schema.GetBlocks()[WaitForKey].(schema.ListNestedBlock).NestedObject.Type()
 				upgradedStateData := applicationResourceModelV1{
 					ModelUUID:                types.StringValue(modelUUID),
+					WaitFor:                  types.ListNull(waitForType),
+					Timeouts:                 nullTimeouts(resp.State.Schema.(schema.Schema)),
 					applicationResourceModel: appV0.applicationResourceModel,
 				}
 
@@ -1522,7 +1782,32 @@ func (o *applicationResource) UpgradeState(ctx context.Context) map[int64]resour
 // If setting an attribute with the import identifier, it is recommended
 // to use the ImportStatePassthroughID() call in this method.
 func (r *applicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// IdentitySchema returns the identity schema of the application resource.
+func (r *applicationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the application, of the form `<model UUID>:<application name>`.")
+}
+
+// ListResourceConfigSchema returns the schema of the application list block.
+func (r *applicationResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = modelUUIDListSchema("applications")
+}
+
+// List lists the applications of a model.
+func (r *applicationResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	listModelResources(ctx, r, r.client, "application", req, stream, func(modelUUID string) ([]listedResource, error) {
+		appNames, err := r.client.Applications.ListApplications(modelUUID)
+		if err != nil {
+			return nil, err
+		}
+		listed := make([]listedResource, 0, len(appNames))
+		for _, appName := range appNames {
+			listed = append(listed, listedResource{ID: newAppID(modelUUID, appName), DisplayName: appName})
+		}
+		return listed, nil
+	})
 }
 
 // ID is '<model UUID>:<app name>'
@@ -1584,6 +1869,93 @@ func assertEqualsMachines(machinesToCompare []string) func(outputFromAPI *juju.R
 	}
 }
 
+// waitForUnitStatus waits for the units of the application to reach the
+// status described by the wait_for block. It returns immediately if the
+// block is not set. When min_ready_units is not set, every unit must be
+// ready and at least expectedUnits units must exist.
+func (r *applicationResource) waitForUnitStatus(ctx context.Context, modelUUID, appName string, waitForList types.List, expectedUnits int, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if waitForList.IsNull() || waitForList.IsUnknown() {
+		return diags
+	}
+	var waitFor []nestedWaitFor
+	diags.Append(waitForList.ElementsAs(ctx, &waitFor, false)...)
+	if diags.HasError() || len(waitFor) == 0 {
+		return diags
+	}
+
+	workloadStatuses := []string{"active"}
+	if !waitFor[0].WorkloadStatus.IsNull() {
+		diags.Append(waitFor[0].WorkloadStatus.ElementsAs(ctx, &workloadStatuses, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	allUnits := waitFor[0].MinReadyUnits.IsNull()
+	minReadyUnits := expectedUnits
+	if !allUnits {
+		minReadyUnits = int(waitFor[0].MinReadyUnits.ValueInt64())
+	}
+	agentStatus := waitFor[0].AgentStatus.ValueString()
+
+	r.trace(fmt.Sprintf("waiting for units of application %q", appName), map[string]interface{}{
+		"workload_status": workloadStatuses,
+		"agent_status":    agentStatus,
+		"min_ready_units": minReadyUnits,
+	})
+	_, err := wait.WaitFor(
+		wait.WaitForCfg[*juju.ReadApplicationInput, *juju.ReadApplicationStatusResponse]{
+			Context: ctx,
+			GetData: r.client.Applications.ReadApplicationStatus,
+			Input: &juju.ReadApplicationInput{
+				ModelUUID: modelUUID,
+				AppName:   appName,
+			},
+			Changes: func() <-chan struct{} { return r.client.ModelChanged(modelUUID) },
+			DataAssertions: []wait.Assert[*juju.ReadApplicationStatusResponse]{
+				assertUnitsReady(workloadStatuses, agentStatus, minReadyUnits, allUnits),
+			},
+			NonFatalErrors: []error{juju.ConnectionRefusedError, juju.RetryReadError, juju.ApplicationNotFoundError},
+			RetryConf: &wait.RetryConf{
+				MaxDuration: timeout,
+				Delay:       2 * time.Second,
+				MaxDelay:    10 * time.Second,
+			},
+		},
+	)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to wait for units of application %q, got error: %s", appName, err))
+	}
+	return diags
+}
+
+// assertUnitsReady returns an assertion which passes once at least
+// minReadyUnits units have one of the workload statuses and, if set,
+// the agent status. If allUnits is true every unit must also be ready.
+// A unit in blocked or error workload status which was not asked for
+// fails the assertion with a non retryable error.
+func assertUnitsReady(workloadStatuses []string, agentStatus string, minReadyUnits int, allUnits bool) func(*juju.ReadApplicationStatusResponse) error {
+	return func(status *juju.ReadApplicationStatusResponse) error {
+		ready := 0
+		for _, unit := range status.Units {
+			if !slices.Contains(workloadStatuses, unit.WorkloadStatus) {
+				if unit.WorkloadStatus == "blocked" || unit.WorkloadStatus == "error" {
+					return errors.Errorf("unit %s is %s: %s", unit.Name, unit.WorkloadStatus, unit.WorkloadMessage)
+				}
+				continue
+			}
+			if agentStatus != "" && unit.AgentStatus != agentStatus {
+				continue
+			}
+			ready++
+		}
+		if ready < minReadyUnits || (allUnits && ready < len(status.Units)) {
+			return juju.NewRetryReadError(fmt.Sprintf("%d of %d units ready", ready, len(status.Units)))
+		}
+		return nil
+	}
+}
+
 // Below we store old schema definitions for the application resource.
 // These are used to upgrade the state of the resource when the schema version changes.
 // Keeping the v0 schema verbatim is the simplest solution currently and permits
@@ -5,8 +5,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -1530,6 +1532,78 @@ resource "juju_application" "{{.AppName}}" {
 	})
 }
 
+func TestAcc_ResourceApplication_LocalCharm(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-local-charm")
+	charmDir := t.TempDir()
+	writeLocalCharm(t, charmDir, "#!/bin/sh\nstatus-set active\n")
+
+	resourceName := "juju_application.testapp"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationLocalCharm(modelName, charmDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-local-charm"),
+					resource.TestCheckResourceAttr(resourceName, "charm.0.name", "tf-local-charm"),
+					resource.TestCheckResourceAttr(resourceName, "charm.0.path", charmDir),
+					resource.TestCheckResourceAttr(resourceName, "charm.0.revision", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "charm.0.hash"),
+				),
+			},
+			{
+				// Rebuilding the charm refreshes the application in place.
+				PreConfig: func() {
+					writeLocalCharm(t, charmDir, "#!/bin/sh\nstatus-set active rebuilt\n")
+				},
+				Config: testAccResourceApplicationLocalCharm(modelName, charmDir),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "charm.0.revision", "1"),
+				),
+			},
+		},
+	})
+}
+
+// writeLocalCharm writes a minimal machine charm with the given dispatch
+// script to dir.
+func writeLocalCharm(t *testing.T, dir, dispatch string) {
+	files := map[string]string{
+		"metadata.yaml": "name: tf-local-charm\nsummary: test\ndescription: test\n",
+		"manifest.yaml": "bases:\n  - name: ubuntu\n    channel: \"22.04\"\n    architectures: [amd64]\n",
+		"dispatch":      dispatch,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccResourceApplicationLocalCharm(modelName, charmPath string) string {
+	return fmt.Sprintf(`
+		resource "juju_model" "testmodel" {
+		  name = %q
+		}
+
+		resource "juju_application" "testapp" {
+		  model_uuid = juju_model.testmodel.uuid
+		  charm {
+			path = %q
+		  }
+		}
+		`, modelName, charmPath)
+}
+
 func testAccResourceApplicationBasic_Minimal(modelName, charmName string) string {
 	return fmt.Sprintf(`
 		resource "juju_model" "testmodel" {
@@ -1545,6 +1619,51 @@ func testAccResourceApplicationBasic_Minimal(modelName, charmName string) string
 		`, modelName, charmName)
 }
 
+func testAccResourceApplicationWaitFor(modelName, appName string, units int) string {
+	return fmt.Sprintf(`
+		resource "juju_model" "this" {
+		  name = %q
+		}
+
+		resource "juju_application" "this" {
+		  model_uuid = juju_model.this.uuid
+		  name       = %q
+		  units      = %d
+		  charm {
+			name = "ubuntu-lite"
+		  }
+		  wait_for {
+			workload_status = ["active"]
+			agent_status    = "idle"
+		  }
+		}
+		`, modelName, appName, units)
+}
+
+// testCheckUnitsWorkloadStatus checks that every unit of the application
+// is in the given workload status once the apply has completed.
+func testCheckUnitsWorkloadStatus(modelName, appName, workloadStatus string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		modelUUID, err := TestClient.Models.ModelUUID(modelName, "")
+		if err != nil {
+			return err
+		}
+		status, err := TestClient.Applications.ReadApplicationStatus(&juju.ReadApplicationInput{
+			ModelUUID: modelUUID,
+			AppName:   appName,
+		})
+		if err != nil {
+			return err
+		}
+		for _, unit := range status.Units {
+			if unit.WorkloadStatus != workloadStatus {
+				return fmt.Errorf("unit %s is %s, expected %s", unit.Name, unit.WorkloadStatus, workloadStatus)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccResourceApplicationVersioned(modelName, appName string, version int) string {
 	switch version {
 	case 0:
@@ -2532,6 +2651,37 @@ resource "juju_application" "this" {
 		`, modelName, appName, constraints)
 }
 
+func TestAcc_ResourceApplication_WaitFor(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	appName := "test-app"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationWaitFor(modelName, appName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "units", "1"),
+					resource.TestCheckResourceAttr("juju_application.this", "wait_for.#", "1"),
+					resource.TestCheckResourceAttr("juju_application.this", "wait_for.0.agent_status", "idle"),
+					testCheckUnitsWorkloadStatus(modelName, appName, "active"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationWaitFor(modelName, appName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "units", "2"),
+					testCheckUnitsWorkloadStatus(modelName, appName, "active"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceApplicationInvalidModelUUID(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-application")
 
@@ -2693,3 +2843,102 @@ func TestCreateCharmResources(t *testing.T) {
 		})
 	}
 }
+
+func TestAssertUnitsReady(t *testing.T) {
+	units := func(statuses ...[3]string) *juju.ReadApplicationStatusResponse {
+		resp := &juju.ReadApplicationStatusResponse{}
+		for i, st := range statuses {
+			resp.Units = append(resp.Units, juju.UnitStatus{
+				Name:            fmt.Sprintf("app/%d", i),
+				WorkloadStatus:  st[0],
+				WorkloadMessage: st[1],
+				AgentStatus:     st[2],
+			})
+		}
+		return resp
+	}
+	tests := []struct {
+		name             string
+		workloadStatuses []string
+		agentStatus      string
+		minReadyUnits    int
+		allUnits         bool
+		status           *juju.ReadApplicationStatusResponse
+		expectRetry      bool
+		expectError      string
+	}{
+		{
+			name:             "All units active and idle",
+			workloadStatuses: []string{"active"},
+			agentStatus:      "idle",
+			minReadyUnits:    2,
+			allUnits:         true,
+			status:           units([3]string{"active", "", "idle"}, [3]string{"active", "", "idle"}),
+		},
+		{
+			name:             "Agent still executing",
+			workloadStatuses: []string{"active"},
+			agentStatus:      "idle",
+			minReadyUnits:    1,
+			allUnits:         true,
+			status:           units([3]string{"active", "", "executing"}),
+			expectRetry:      true,
+		},
+		{
+			name:             "Units not yet added",
+			workloadStatuses: []string{"active"},
+			minReadyUnits:    3,
+			allUnits:         true,
+			status:           units([3]string{"active", "", "idle"}),
+			expectRetry:      true,
+		},
+		{
+			name:             "Minimum ready units reached",
+			workloadStatuses: []string{"active"},
+			minReadyUnits:    1,
+			status:           units([3]string{"active", "", "idle"}, [3]string{"maintenance", "installing", "executing"}),
+		},
+		{
+			name:             "Blocked unit fails",
+			workloadStatuses: []string{"active"},
+			minReadyUnits:    1,
+			status:           units([3]string{"blocked", "missing relation", "idle"}),
+			expectError:      "unit app/0 is blocked: missing relation",
+		},
+		{
+			name:             "Error unit fails",
+			workloadStatuses: []string{"active"},
+			minReadyUnits:    1,
+			status:           units([3]string{"error", "hook failed: \"install\"", "idle"}),
+			expectError:      "unit app/0 is error: hook failed: \"install\"",
+		},
+		{
+			name:             "Blocked status requested",
+			workloadStatuses: []string{"active", "blocked"},
+			minReadyUnits:    1,
+			allUnits:         true,
+			status:           units([3]string{"blocked", "missing relation", "idle"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := assertUnitsReady(tt.workloadStatuses, tt.agentStatus, tt.minReadyUnits, tt.allUnits)(tt.status)
+			switch {
+			case tt.expectError != "":
+				if err == nil || err.Error() != tt.expectError {
+					t.Errorf("assertUnitsReady() error = %v, expected %q", err, tt.expectError)
+				}
+				if errors.Is(err, juju.RetryReadError) {
+					t.Errorf("assertUnitsReady() error = %v, expected a non retryable error", err)
+				}
+			case tt.expectRetry:
+				if !errors.Is(err, juju.RetryReadError) {
+					t.Errorf("assertUnitsReady() error = %v, expected a retry error", err)
+				}
+			case err != nil:
+				t.Errorf("assertUnitsReady() unexpected error = %v", err)
+			}
+		})
+	}
+}
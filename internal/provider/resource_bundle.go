@@ -0,0 +1,531 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/wait"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &bundleResource{}
+var _ resource.ResourceWithConfigure = &bundleResource{}
+var _ resource.ResourceWithModifyPlan = &bundleResource{}
+
+func NewBundleResource() resource.Resource {
+	return &bundleResource{}
+}
+
+type bundleResource struct {
+	client         *juju.Client
+	providerConfig juju.Config
+
+	// subCtx is the context created with the new tflog subsystem for bundles.
+	subCtx context.Context
+}
+
+type bundleResourceModel struct {
+	ModelUUID    types.String `tfsdk:"model_uuid"`
+	Name         types.String `tfsdk:"name"`
+	Channel      types.String `tfsdk:"channel"`
+	Bundle       types.String `tfsdk:"bundle"`
+	Overlays     types.List   `tfsdk:"overlays"`
+	Applications types.Set    `tfsdk:"applications"`
+	Integrations types.Set    `tfsdk:"integrations"`
+	Machines     types.Set    `tfsdk:"machines"`
+	Offers       types.Set    `tfsdk:"offers"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *bundleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle"
+}
+
+func (r *bundleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a Juju bundle, deployed and managed as a single unit. " +
+			"Applications, integrations, offers and machines described by the bundle are created in " +
+			"dependency order, and only the changes between the bundle and the model are applied. " +
+			"Applications and offers removed from the bundle are destroyed on update, while machines added " +
+			"by the bundle are only removed when the bundle is destroyed. Local charms, local resources " +
+			"and consumed offers are not supported.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The UUID of the model to deploy the bundle to. Changing this value will cause the" +
+					" bundle to be destroyed and recreated by terraform.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of a bundle published on Charmhub. Changing this value will cause the" +
+					" bundle to be destroyed and recreated by terraform.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("bundle")),
+				},
+			},
+			"channel": schema.StringAttribute{
+				Description: "The channel to use when fetching the bundle from Charmhub.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("name")),
+					StringIsChannelValidator{},
+				},
+			},
+			"bundle": schema.StringAttribute{
+				Description: "The bundle, in YAML format. Overlay sections, separated by `---`, are allowed.",
+				Optional:    true,
+			},
+			"overlays": schema.ListAttribute{
+				Description: "Overlays, in YAML format, to apply in order on top of the bundle.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"applications": schema.SetAttribute{
+				Description: "The names of the applications deployed by the bundle.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"integrations": schema.SetAttribute{
+				Description: "The integrations between the applications of the bundle, " +
+					"in the format `<application>:<endpoint> <application>:<endpoint>`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"machines": schema.SetAttribute{
+				Description: "The IDs of the machines added to the model by the bundle.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"offers": schema.SetAttribute{
+				Description: "The URLs of the offers created by the bundle.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the bundle, `<model_uuid>:<name>`. For an inline bundle, the name is " +
+					"`inline-<hash>` where the hash is computed from the bundle and its overlays, so the ID " +
+					"changes when they do.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ModifyPlan sets the ID of the bundle in the plan, as the ID of an
+// inline bundle changes with its content.
+func (r *bundleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the bundle is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan bundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ModelUUID.IsUnknown() || plan.Name.IsUnknown() || plan.Bundle.IsUnknown() || plan.Overlays.IsUnknown() {
+		return
+	}
+	for _, overlay := range plan.Overlays.Elements() {
+		if overlay.IsUnknown() {
+			return
+		}
+	}
+	var overlays []string
+	resp.Diagnostics.Append(plan.Overlays.ElementsAs(ctx, &overlays, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"),
+		types.StringValue(newIDForBundle(plan.ModelUUID.ValueString(), plan.Name.ValueString(), plan.Bundle.ValueString(), overlays)))...)
+}
+
+func (r *bundleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = provider.Client
+	r.providerConfig = provider.Config
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceBundle)
+}
+
+func (r *bundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "bundle", "create")
+		return
+	}
+
+	var plan bundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, dErr := r.applyBundleInput(ctx, plan)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	response, err := r.client.Bundles.ApplyBundle(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy bundle, got error: %s", err))
+		return
+	}
+	r.trace("Created bundle", map[string]interface{}{
+		"applications": response.Applications,
+		"machines":     response.Machines,
+	})
+
+	plan.ID = types.StringValue(newIDForBundle(input.ModelUUID, input.CharmhubBundle, input.Bundle, input.Overlays))
+	// The bundle is deployed, save the state even if the computed
+	// values cannot all be set.
+	resp.Diagnostics.Append(plan.setComputed(ctx, response.Applications, response.Integrations, response.Machines, response.Offers)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *bundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "bundle", "read")
+		return
+	}
+
+	var state bundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, dErr := state.readBundleInput(ctx)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	response, err := r.client.Bundles.ReadBundle(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bundle, got error: %s", err))
+		return
+	}
+	r.trace("Read bundle", map[string]interface{}{
+		"applications": response.Applications,
+		"machines":     response.Machines,
+	})
+
+	// If every application of the bundle has been removed outside of
+	// terraform, the bundle is gone and has to be deployed again.
+	if len(input.Applications) > 0 && len(response.Applications) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setComputed(ctx, response.Applications, response.Integrations, response.Machines, response.Offers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update re-applies the bundle. Only the differences between the bundle
+// and the model are applied, then the applications and offers which are
+// no longer part of the bundle are removed.
+func (r *bundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "bundle", "update")
+		return
+	}
+
+	var plan, state bundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, dErr := r.applyBundleInput(ctx, plan)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	response, err := r.client.Bundles.ApplyBundle(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update bundle, got error: %s", err))
+		return
+	}
+
+	stateEntities, dErr := state.readBundleInput(ctx)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	removed := &juju.DestroyBundleInput{
+		ModelUUID:    plan.ModelUUID.ValueString(),
+		Applications: missingFrom(stateEntities.Applications, response.Applications),
+		Offers:       missingFrom(stateEntities.Offers, response.Offers),
+	}
+
+	// Machines are never removed when the bundle is updated, as they
+	// may still host units of the applications, so keep track of all
+	// the machines ever added by the bundle.
+	machines := append(stateEntities.Machines, missingFrom(response.Machines, stateEntities.Machines)...)
+
+	if len(removed.Applications) > 0 || len(removed.Offers) > 0 {
+		// Save what the bundle applied before removing the entities no
+		// longer part of it. The previous configuration is kept, so
+		// that the removal is retried by the next apply if it fails.
+		resp.Diagnostics.Append(state.setComputed(ctx,
+			append(slices.Clone(response.Applications), removed.Applications...), response.Integrations, machines,
+			append(slices.Clone(response.Offers), removed.Offers...))...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		r.trace("Removing entities no longer in the bundle", map[string]interface{}{
+			"applications": removed.Applications,
+			"offers":       removed.Offers,
+		})
+		if err := r.client.Bundles.DestroyBundle(removed); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove applications no longer in the bundle, got error: %s", err))
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(newIDForBundle(input.ModelUUID, input.CharmhubBundle, input.Bundle, input.Overlays))
+	resp.Diagnostics.Append(plan.setComputed(ctx, response.Applications, response.Integrations, machines, response.Offers)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+//
+// The offers and applications of the bundle are destroyed first, then
+// the machines added by the bundle once they no longer host any unit.
+func (r *bundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "bundle", "delete")
+		return
+	}
+
+	var state bundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace("Deleting", map[string]interface{}{
+		"ID": state.ID.ValueString(),
+	})
+
+	input, dErr := state.readBundleInput(ctx)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
+	if err := r.client.Bundles.DestroyBundle(&juju.DestroyBundleInput{
+		ModelUUID:    input.ModelUUID,
+		Applications: input.Applications,
+		Offers:       input.Offers,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete bundle, got error: %s", err))
+		return
+	}
+
+	_, err := wait.WaitFor(
+		wait.WaitForCfg[*juju.ReadBundleInput, *juju.ReadBundleResponse]{
+			Context: ctx,
+			GetData: r.client.Bundles.ReadBundle,
+			Input: &juju.ReadBundleInput{
+				ModelUUID:    input.ModelUUID,
+				Applications: input.Applications,
+			},
+			Changes: func() <-chan struct{} { return r.client.ModelChanged(input.ModelUUID) },
+			DataAssertions: []wait.Assert[*juju.ReadBundleResponse]{
+				func(response *juju.ReadBundleResponse) error {
+					if len(response.Applications) > 0 {
+						return juju.NewRetryReadError(fmt.Sprintf("applications %v are still being removed", response.Applications))
+					}
+					return nil
+				},
+			},
+			NonFatalErrors: []error{juju.ConnectionRefusedError, juju.RetryReadError},
+		},
+	)
+	if err != nil {
+		r.addDeletionDiagnostic(&resp.Diagnostics, fmt.Sprintf("Unable to complete bundle applications deletion: %v\n", err))
+		return
+	}
+
+	for _, machineID := range input.Machines {
+		if err := r.client.Machines.DestroyMachine(&juju.DestroyMachineInput{
+			ModelUUID: input.ModelUUID,
+			ID:        machineID,
+		}); err != nil {
+			r.addDeletionDiagnostic(&resp.Diagnostics, fmt.Sprintf("Unable to delete bundle machine %q: %v\n", machineID, err))
+		}
+	}
+}
+
+func (r *bundleResource) addDeletionDiagnostic(diags *diag.Diagnostics, errDetail string) {
+	errSummary := "Client Error"
+	if r.providerConfig.SkipFailedDeletion {
+		diags.AddWarning(
+			errSummary,
+			errDetail+"There might be dangling resources requiring manual intervention.\n",
+		)
+	} else {
+		diags.AddError(
+			errSummary,
+			errDetail,
+		)
+	}
+}
+
+func (r *bundleResource) applyBundleInput(ctx context.Context, plan bundleResourceModel) (*juju.ApplyBundleInput, diag.Diagnostics) {
+	var overlays []string
+	diags := plan.Overlays.ElementsAs(ctx, &overlays, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &juju.ApplyBundleInput{
+		ModelUUID:      plan.ModelUUID.ValueString(),
+		Bundle:         plan.Bundle.ValueString(),
+		CharmhubBundle: plan.Name.ValueString(),
+		Channel:        plan.Channel.ValueString(),
+		Overlays:       overlays,
+		OfferOwner:     r.client.Username(),
+	}, nil
+}
+
+// readBundleInput returns the entities of the model tracked in the state
+// of the bundle.
+func (m bundleResourceModel) readBundleInput(ctx context.Context) (*juju.ReadBundleInput, diag.Diagnostics) {
+	input := &juju.ReadBundleInput{
+		ModelUUID: m.ModelUUID.ValueString(),
+	}
+	var diags diag.Diagnostics
+	diags.Append(m.Applications.ElementsAs(ctx, &input.Applications, false)...)
+	diags.Append(m.Machines.ElementsAs(ctx, &input.Machines, false)...)
+	diags.Append(m.Offers.ElementsAs(ctx, &input.Offers, false)...)
+	return input, diags
+}
+
+func (m *bundleResourceModel) setComputed(ctx context.Context, applications, integrations, machines, offers []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Applications = stringSet(ctx, applications, &diags)
+	m.Integrations = stringSet(ctx, integrations, &diags)
+	m.Machines = stringSet(ctx, machines, &diags)
+	m.Offers = stringSet(ctx, offers, &diags)
+	return diags
+}
+
+// stringSet returns the set of values, empty rather than null if there
+// are none. If the set cannot be built, the error is added to diags and
+// an empty set is returned, so that the state can still be saved.
+func stringSet(ctx context.Context, values []string, diags *diag.Diagnostics) types.Set {
+	set, dErr := types.SetValueFrom(ctx, types.StringType, nonNilStrings(values))
+	diags.Append(dErr...)
+	if dErr.HasError() {
+		return types.SetValueMust(types.StringType, []attr.Value{})
+	}
+	return set
+}
+
+// nonNilStrings returns an empty slice instead of nil, so that empty
+// computed sets are stored as empty rather than null.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// missingFrom returns the values which are not found in others.
+func missingFrom(values, others []string) []string {
+	var missing []string
+	for _, value := range values {
+		if !slices.Contains(others, value) {
+			missing = append(missing, value)
+		}
+	}
+	return missing
+}
+
+// inlineBundleName returns the name identifying an inline bundle, derived
+// from its content so that several inline bundles can be deployed to the
+// same model.
+func inlineBundleName(bundle string, overlays []string) string {
+	hash := sha256.New()
+	hash.Write([]byte(bundle))
+	for _, overlay := range overlays {
+		hash.Write([]byte("\n---\n"))
+		hash.Write([]byte(overlay))
+	}
+	return "inline-" + hex.EncodeToString(hash.Sum(nil))[:12]
+}
+
+// newIDForBundle returns the ID of a bundle, named after the Charmhub
+// bundle or, for an inline bundle, after its content.
+func newIDForBundle(modelUUID, charmhubBundle, bundle string, overlays []string) string {
+	name := charmhubBundle
+	if name == "" {
+		name = inlineBundleName(bundle, overlays)
+	}
+	return fmt.Sprintf("%s:%s", modelUUID, name)
+}
+
+func (r *bundleResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceBundle, msg, additionalFields...)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInlineBundleName(t *testing.T) {
+	name := inlineBundleName("applications: {}", nil)
+	assert.Regexp(t, "^inline-[0-9a-f]{12}$", name)
+	assert.Equal(t, name, inlineBundleName("applications: {}", nil))
+	assert.NotEqual(t, name, inlineBundleName("applications: {a: {charm: a}}", nil))
+	assert.NotEqual(t, name, inlineBundleName("applications: {}", []string{"applications: {}"}))
+}
+
+func TestNewIDForBundle(t *testing.T) {
+	modelUUID := "fff19e46-8a0b-4a52-8e1d-7b16a40f8f2a"
+	assert.Equal(t, modelUUID+":kubeflow", newIDForBundle(modelUUID, "kubeflow", "", nil))
+	assert.Equal(t, modelUUID+":"+inlineBundleName("applications: {}", nil), newIDForBundle(modelUUID, "", "applications: {}", nil))
+}
+
+func TestAcc_ResourceBundle(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-bundle")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBundle(modelName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("juju_model.this", "uuid", "juju_bundle.this", "model_uuid"),
+					resource.TestCheckResourceAttr("juju_bundle.this", "applications.#", "2"),
+					resource.TestCheckTypeSetElemAttr("juju_bundle.this", "applications.*", "source"),
+					resource.TestCheckTypeSetElemAttr("juju_bundle.this", "applications.*", "sink"),
+					resource.TestCheckResourceAttr("juju_bundle.this", "integrations.#", "1"),
+					resource.TestCheckTypeSetElemAttr("juju_bundle.this", "integrations.*", "sink:source source:sink"),
+					resource.TestCheckResourceAttr("juju_bundle.this", "machines.#", "2"),
+					resource.TestCheckResourceAttr("juju_bundle.this", "offers.#", "1"),
+					resource.TestCheckTypeSetElemAttr("juju_bundle.this", "offers.*",
+						fmt.Sprintf("%v/%v.%v", expectedResourceOwner(), modelName, "source")),
+				),
+			},
+			{
+				Config: testAccResourceBundle(modelName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_bundle.this", "applications.#", "1"),
+					resource.TestCheckTypeSetElemAttr("juju_bundle.this", "applications.*", "source"),
+					resource.TestCheckResourceAttr("juju_bundle.this", "integrations.#", "0"),
+					resource.TestCheckResourceAttr("juju_bundle.this", "machines.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceBundle(modelName string, withSink bool) string {
+	sink := ""
+	relations := ""
+	if withSink {
+		sink = `
+  sink:
+    charm: juju-qa-dummy-sink
+    base: ubuntu@22.04
+    num_units: 1`
+		relations = `
+relations:
+  - [sink:source, source:sink]`
+	}
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_bundle" "this" {
+  model_uuid = juju_model.this.uuid
+
+  bundle = <<-EOT
+applications:
+  source:
+    charm: juju-qa-dummy-source
+    base: ubuntu@22.04
+    num_units: 1%s%s
+EOT
+
+  overlays = [<<-EOT
+applications:
+  source:
+    offers:
+      source:
+        endpoints: [sink]
+EOT
+  ]
+}
+`, modelName, sink, relations)
+}
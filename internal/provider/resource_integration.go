@@ -9,12 +9,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -32,11 +35,20 @@ var _ resource.Resource = &integrationResource{}
 var _ resource.ResourceWithConfigure = &integrationResource{}
 var _ resource.ResourceWithImportState = &integrationResource{}
 var _ resource.ResourceWithValidateConfig = &integrationResource{}
+var _ resource.ResourceWithModifyPlan = &integrationResource{}
+var _ resource.ResourceWithIdentity = &integrationResource{}
+var _ list.ListResourceWithConfigure = &integrationResource{}
 
 func NewIntegrationResource() resource.Resource {
 	return &integrationResource{}
 }
 
+// NewIntegrationListResource returns a new instance of the integration list resource,
+// which lists the integrations of a model.
+func NewIntegrationListResource() list.ListResource {
+	return &integrationResource{}
+}
+
 type integrationResource struct {
 	client *juju.Client
 	config juju.Config
@@ -61,7 +73,11 @@ type integrationResourceModelV0 struct {
 type integrationResourceModelV1 struct {
 	integrationResourceModel
 
-	ModelUUID types.String `tfsdk:"model_uuid"`
+	ModelUUID       types.String   `tfsdk:"model_uuid"`
+	Suspended       types.Bool     `tfsdk:"suspended"`
+	SuspendedReason types.String   `tfsdk:"suspended_reason"`
+	OfferController types.List     `tfsdk:"offer_controller"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 // nestedApplication represents an element in an Application set of an
@@ -74,7 +90,35 @@ type nestedApplication struct {
 
 func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// IdentitySchema returns the identity schema of the integration resource.
+func (r *integrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the integration, of the form `<model UUID>:<provider>:<endpoint>:<requirer>:<endpoint>`.")
+}
+
+// ListResourceConfigSchema returns the schema of the integration list block.
+func (r *integrationResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = modelUUIDListSchema("integrations")
+}
+
+// List lists the integrations of a model.
+func (r *integrationResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	listModelResources(ctx, r, r.client, "integration", req, stream, func(modelUUID string) ([]listedResource, error) {
+		integrations, err := r.client.Integrations.ListIntegrations(modelUUID)
+		if err != nil {
+			return nil, err
+		}
+		listed := make([]listedResource, 0, len(integrations))
+		for _, apps := range integrations {
+			listed = append(listed, listedResource{
+				ID:          newIDForIntegrationResource(modelUUID, apps),
+				DisplayName: fmt.Sprintf("%s:%s %s:%s", apps[0].Name, apps[0].Endpoint, apps[1].Name, apps[1].Endpoint),
+			})
+		}
+		return listed, nil
+	})
 }
 
 func (r *integrationResource) Configure(ctx context.Context, req resource.ConfigureRequest,
@@ -113,12 +157,64 @@ func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.V
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	crossController := false
+	consumer := false
 	for _, app := range apps {
 		if app.Name.IsNull() && app.OfferURL.IsNull() {
 			resp.Diagnostics.AddAttributeError(path.Root("applications"), "Attribute Error", "one and only one of \"name\" or \"offer_url\" fields must be provided.")
 		} else if !app.OfferURL.IsNull() && !app.Name.IsNull() {
 			resp.Diagnostics.AddAttributeError(path.Root("applications"), "Attribute Error", "the \"offer_url\" and \"name\" fields are mutually exclusive.")
 		}
+		if offerURLHasSource(app.OfferURL.ValueString()) {
+			crossController = true
+		}
+		if !app.OfferURL.IsNull() {
+			consumer = true
+		}
+	}
+	if len(configData.OfferController.Elements()) > 0 && !crossController {
+		resp.Diagnostics.AddAttributeError(path.Root(OfferControllerKey), "Attribute Error",
+			"\"offer_controller\" requires an \"offer_url\" prefixed with the name of the controller hosting the offer, e.g. \"controller:admin/model.offer\".")
+	}
+	if configData.SuspendedReason.ValueString() != "" && !configData.Suspended.IsUnknown() && !configData.Suspended.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("suspended_reason"), "Attribute Error",
+			"\"suspended_reason\" can only be set when \"suspended\" is true.")
+	}
+	// Juju only allows suspending an integration from the model hosting
+	// the offer, where the consumer is a remote application without an
+	// offer URL.
+	if consumer && configData.Suspended.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("suspended"), "Attribute Error",
+			"an integration consuming an offer cannot be suspended, suspend it from the model hosting the offer.")
+	}
+}
+
+// ModifyPlan clears the suspended reason of integrations being resumed,
+// and prevents changing the reason of a suspended integration, as Juju
+// only records the reason when the integration is suspended.
+func (r *integrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the integration is created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state integrationResourceModelV1
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.SuspendedReason.IsUnknown() || plan.Suspended.IsUnknown() {
+		return
+	}
+	if !plan.Suspended.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("suspended_reason"), "")...)
+		return
+	}
+	if state.Suspended.ValueBool() && plan.Suspended.ValueBool() &&
+		plan.SuspendedReason.ValueString() != state.SuspendedReason.ValueString() {
+		resp.Diagnostics.AddAttributeError(path.Root("suspended_reason"), "Attribute Error",
+			"the reason of a suspended integration cannot be changed, resume the integration first.")
 	}
 }
 
@@ -126,7 +222,7 @@ func (r *integrationResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_integration"
 }
 
-func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *integrationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version:     1,
 		Description: "A resource that represents a Juju Integration.",
@@ -148,6 +244,26 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"suspended": schema.BoolAttribute{
+				Description: "Whether the integration is suspended. Suspending an integration stops the " +
+					"communication between the applications without removing the integration and its data. " +
+					"Juju only allows suspending cross-model integrations, from the model hosting the offer: " +
+					"it cannot be set to true for an integration with an `offer_url`. In the consuming model, " +
+					"this attribute reports whether the integration was suspended.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"suspended_reason": schema.StringAttribute{
+				Description: "The reason the integration is suspended. It can only be set when suspending the integration.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -189,7 +305,8 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 						},
 						"offer_url": schema.StringAttribute{
 							Description: "The URL of a remote application. This attribute may not be used at the" +
-								" same time as name and endpoint.",
+								" same time as name and endpoint. To consume an offer hosted on another controller," +
+								" prefix the URL with the name of that controller, e.g. \"controller:admin/model.offer\".",
 							Optional: true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
@@ -204,6 +321,17 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					},
 				},
 			},
+			OfferControllerKey: offerControllerBlock("integration"),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: timeoutDescription("the applications to be available before integrating them", juju.IntegrationAppAvailableTimeout),
+				Read:              true,
+				ReadDescription:   timeoutDescription("the integration to be read", defaultReadTimeout),
+				Update:            true,
+				UpdateDescription: timeoutDescription("the integration to be suspended or resumed", defaultUpdateTimeout),
+				Delete:            true,
+				DeleteDescription: timeoutDescription("the integration to be removed", defaultDeleteTimeout),
+			}),
 		},
 	}
 }
@@ -239,9 +367,15 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	// If we have an offer URL, we need to consume it (creating a remote-app) before creating the integration.
 	// If the remote-app already exists, we will re-use it (see `ConsumeRemoteOffer` for more details).
 	if offer != nil {
+		offerController, diags := sourceController(ctx, plan.OfferController)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		offerResponse, err := r.client.Offers.ConsumeRemoteOffer(&juju.ConsumeRemoteOfferInput{
-			ModelUUID: modelUUID,
-			OfferURL:  offer.url,
+			ModelUUID:        modelUUID,
+			OfferURL:         offer.url,
+			SourceController: offerController,
 		})
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to consume remote offer, got error: %s", err))
@@ -261,11 +395,18 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	viaCIDRs := plan.Via.ValueString()
+	createTimeout, diags := plan.Timeouts.Create(ctx, juju.IntegrationAppAvailableTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, err := r.client.Integrations.CreateIntegration(&juju.IntegrationInput{
-		ModelUUID: modelUUID,
-		Apps:      appNames,
-		Endpoints: endpoints,
-		ViaCIDRs:  viaCIDRs,
+		ModelUUID:           modelUUID,
+		Apps:                appNames,
+		Endpoints:           endpoints,
+		ViaCIDRs:            viaCIDRs,
+		AppAvailableTimeout: createTimeout,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration, got error: %s", err))
@@ -273,7 +414,7 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	r.trace(fmt.Sprintf("integration created on Juju between %q at %q on model %q", appNames, endpoints, modelUUID))
 
-	parsedApplications, err := parseApplications(response.Applications)
+	parsedApplications, err := parseApplications(response.Applications, apps)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse applications, got error: %s", err))
 		return
@@ -290,8 +431,38 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	id := newIDForIntegrationResource(modelUUID, response.Applications)
 	plan.ID = types.StringValue(id)
 
+	// Save the integration before suspending it, so that it is tracked
+	// by Terraform even if the suspension fails.
+	suspend := plan.Suspended.ValueBool()
+	reason := plan.SuspendedReason.ValueString()
+	plan.Suspended = types.BoolValue(false)
+	plan.SuspendedReason = types.StringValue("")
 	r.trace(fmt.Sprintf("integration resource created: %q", id))
 	// Write the state plan into the Response.State
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !suspend {
+		return
+	}
+
+	modelUUID, endpointA, endpointB, idErr := modelUUIDAndEndpointsFromID(id)
+	resp.Diagnostics.Append(idErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err = r.client.Integrations.SetIntegrationSuspended(&juju.SetIntegrationSuspendedInput{
+		ModelUUID: modelUUID,
+		Endpoints: []string{endpointA, endpointB},
+		Suspended: true,
+		Reason:    reason,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to suspend integration, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("integration suspended: %q", id))
+	plan.Suspended = types.BoolValue(true)
+	plan.SuspendedReason = types.StringValue(reason)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -309,6 +480,7 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, state.ID)...)
 
 	modelUUID, endpointA, endpointB, idErr := modelUUIDAndEndpointsFromID(state.ID.ValueString())
 	if idErr.HasError() {
@@ -324,7 +496,18 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 		},
 	}
 
-	response, err := r.client.Integrations.ReadIntegration(integration)
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response *juju.ReadIntegrationResponse
+	err := retryOnConnectionRefused(ctx, readTimeout, func() error {
+		var err error
+		response, err = r.client.Integrations.ReadIntegration(integration)
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.Append(handleIntegrationNotFoundError(ctx, err, &resp.State)...)
 		return
@@ -332,13 +515,25 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	r.trace(fmt.Sprintf("found integration: %v", integration))
 
 	state.ModelUUID = types.StringValue(modelUUID)
+	state.Suspended = types.BoolValue(response.Suspended)
+	state.SuspendedReason = types.StringValue(response.SuspendedReason)
+
+	var stateApps []nestedApplication
+	resp.Diagnostics.Append(state.Application.ElementsAs(ctx, &stateApps, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	applications, err := parseApplications(response.Applications)
+	applications, err := parseApplications(response.Applications, stateApps)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse applications, got error: %s", err))
 		return
 	}
 
+	// The offer URL of a remote application is read without the name of
+	// the controller hosting the offer, keep the one in state.
+	keepOfferURLSource(applications, stateApps)
+
 	appType := req.State.Schema.GetBlocks()["application"].(schema.SetNestedBlock).NestedObject.Type()
 	apps, aErr := types.SetValueFrom(ctx, appType, applications)
 	if aErr.HasError() {
@@ -352,8 +547,56 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Update is a no-op, as all fields force replacement.
+// Update suspends or resumes the integration, and saves the timeouts and
+// the offer controller, as all other fields force replacement.
 func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "integration", "update")
+		return
+	}
+
+	var plan, state integrationResourceModelV1
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Suspended.IsUnknown() && plan.Suspended.ValueBool() != state.Suspended.ValueBool() {
+		modelUUID, endpointA, endpointB, idErr := modelUUIDAndEndpointsFromID(state.ID.ValueString())
+		resp.Diagnostics.Append(idErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := retryOnConnectionRefused(ctx, updateTimeout, func() error {
+			return r.client.Integrations.SetIntegrationSuspended(&juju.SetIntegrationSuspendedInput{
+				ModelUUID: modelUUID,
+				Endpoints: []string{endpointA, endpointB},
+				Suspended: plan.Suspended.ValueBool(),
+				Reason:    plan.SuspendedReason.ValueString(),
+			})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration, got error: %s", err))
+			return
+		}
+		r.trace(fmt.Sprintf("integration %q suspended: %t", state.ID.ValueString(), plan.Suspended.ValueBool()))
+		state.Suspended = plan.Suspended
+		state.SuspendedReason = types.StringValue("")
+		if plan.Suspended.ValueBool() {
+			state.SuspendedReason = types.StringValue(plan.SuspendedReason.ValueString())
+		}
+	}
+	state.Timeouts = plan.Timeouts
+	state.OfferController = plan.OfferController
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, state.ID)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 // Delete removes the integration and intentionally avoids deleting any consumed offers
@@ -376,6 +619,11 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 		resp.Diagnostics.Append(idErr...)
 		return
 	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	endpoints := []string{endpointA, endpointB}
 	err := r.client.Integrations.DestroyIntegration(&juju.IntegrationInput{
 		ModelUUID: modelUUID,
@@ -394,8 +642,12 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 				ModelUUID: modelUUID,
 				Endpoints: endpoints,
 			},
+			Changes:        func() <-chan struct{} { return r.client.ModelChanged(modelUUID) },
 			ExpectedErr:    juju.IntegrationNotFoundError,
 			RetryAllErrors: true,
+			RetryConf: &wait.RetryConf{
+				MaxDuration: deleteTimeout,
+			},
 		},
 	)
 	if err != nil {
@@ -440,13 +692,18 @@ func (r *integrationResource) UpgradeState(ctx context.Context) map[int64]resour
 
 				newID := strings.Replace(integrationV0.ID.ValueString(), integrationV0.ModelName.ValueString(), modelUUID, 1)
 
+				offerControllerType := resp.State.Schema.GetBlocks()[OfferControllerKey].(schema.ListNestedBlock).NestedObject.Type()
 				upgradedStateData := integrationResourceModelV1{
 					integrationResourceModel: integrationResourceModel{
 						Via:         integrationV0.Via,
 						ID:          types.StringValue(newID),
 						Application: integrationV0.Application,
 					},
-					ModelUUID: types.StringValue(modelUUID),
+					ModelUUID:       types.StringValue(modelUUID),
+					Suspended:       types.BoolNull(),
+					SuspendedReason: types.StringNull(),
+					OfferController: types.ListNull(offerControllerType),
+					Timeouts:        nullTimeouts(resp.State.Schema.(schema.Schema)),
 				}
 
 				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
@@ -539,13 +796,17 @@ func parseEndpoints(apps []nestedApplication) (endpoints []string, of *offer, ap
 	return endpoints, of, appNames, nil
 }
 
-func parseApplications(apps []juju.Application) ([]nestedApplication, error) {
+// parseApplications converts the applications of an integration to the
+// application blocks of the resource. A consumed offer is represented by
+// its offer_url, unless the configured applications refer to it by name,
+// e.g. when it is managed by a juju_saas resource.
+func parseApplications(apps []juju.Application, configured []nestedApplication) ([]nestedApplication, error) {
 	applications := make([]nestedApplication, 2)
 
 	for i, app := range apps {
 		a := nestedApplication{}
 
-		if app.OfferURL != nil {
+		if app.OfferURL != nil && !configuredByName(configured, app.Name) {
 			url := *app.OfferURL
 			a.OfferURL = types.StringValue(url)
 			a.Endpoint = types.StringValue(app.Endpoint)
@@ -559,6 +820,34 @@ func parseApplications(apps []juju.Application) ([]nestedApplication, error) {
 	return applications, nil
 }
 
+// configuredByName returns whether one of the configured applications
+// refers to the application with the given name.
+func configuredByName(configured []nestedApplication, name string) bool {
+	for _, app := range configured {
+		if app.OfferURL.IsNull() && app.Name.ValueString() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// keepOfferURLSource sets the offer URL of the applications to the one
+// in state if they only differ by the name of the controller hosting
+// the offer, which is not part of the offer URL read from Juju.
+func keepOfferURLSource(applications, stateApps []nestedApplication) {
+	for i, app := range applications {
+		if app.OfferURL.IsNull() {
+			continue
+		}
+		for _, stateApp := range stateApps {
+			if offerURLHasSource(stateApp.OfferURL.ValueString()) &&
+				sameOfferIgnoringSource(stateApp.OfferURL.ValueString(), app.OfferURL.ValueString()) {
+				applications[i].OfferURL = stateApp.OfferURL
+			}
+		}
+	}
+}
+
 func (r *integrationResource) trace(msg string, additionalFields ...map[string]interface{}) {
 	if r.subCtx == nil {
 		return
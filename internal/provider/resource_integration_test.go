@@ -4,10 +4,12 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -15,8 +17,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestKeepOfferURLSource(t *testing.T) {
+	applications := []nestedApplication{
+		{Name: types.StringValue("discourse"), Endpoint: types.StringValue("database"), OfferURL: types.StringNull()},
+		{Name: types.StringNull(), Endpoint: types.StringNull(), OfferURL: types.StringValue("admin/db.postgresql")},
+	}
+	stateApps := []nestedApplication{
+		{Name: types.StringValue("discourse"), Endpoint: types.StringValue("database"), OfferURL: types.StringNull()},
+		{Name: types.StringNull(), Endpoint: types.StringNull(), OfferURL: types.StringValue("other:admin/db.postgresql")},
+	}
+	keepOfferURLSource(applications, stateApps)
+	assert.True(t, applications[0].OfferURL.IsNull())
+	assert.Equal(t, "other:admin/db.postgresql", applications[1].OfferURL.ValueString())
+
+	// A different offer in state is not kept.
+	applications[1].OfferURL = types.StringValue("admin/db.mysql")
+	keepOfferURLSource(applications, stateApps)
+	assert.Equal(t, "admin/db.mysql", applications[1].OfferURL.ValueString())
+}
+
 func TestAcc_ResourceIntegration(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -35,6 +57,7 @@ func TestAcc_ResourceIntegration(t *testing.T) {
 					resource.TestCheckResourceAttrPair("juju_model.this", "uuid", "juju_integration.this", "model_uuid"),
 					resource.TestCheckResourceAttr("juju_integration.this", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.this", "application.*", map[string]string{"name": "one", "endpoint": "source"}),
+					testAccCheckListed(NewIntegrationListResource, "juju_integration.this", map[string]string{"model_uuid": "model_uuid"}, "model_uuid"),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue("juju_integration.this", tfjsonpath.New("id"), knownvalue.StringRegexp(idCheck)),
@@ -410,6 +433,8 @@ func TestAcc_ResourceIntegrationWithMultipleConsumers(t *testing.T) {
 					resource.TestCheckResourceAttrPair("juju_model.b", "uuid", "juju_integration.b1.0", "model_uuid"),
 					resource.TestCheckResourceAttr("juju_integration.b1.0", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b1.0", "application.*", map[string]string{"name": "b1", "endpoint": "sink"}),
+					resource.TestCheckResourceAttr("juju_integration.b1.0", "suspended", "false"),
+					resource.TestCheckResourceAttr("juju_integration.b1.0", "suspended_reason", ""),
 					resource.TestCheckResourceAttrPair("juju_model.b", "uuid", "juju_integration.b2.0", "model_uuid"),
 					resource.TestCheckResourceAttr("juju_integration.b2.0", "application.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b2.0", "application.*", map[string]string{"name": "b2", "endpoint": "sink"}),
@@ -643,3 +668,191 @@ resource "juju_integration" "this2" {
 }
 `, srcModelName, dstModelName)
 }
+
+func TestAcc_ResourceIntegrationSuspended(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	srcModelName := acctest.RandomWithPrefix("tf-test-integration-offering")
+	dstModelName := acctest.RandomWithPrefix("tf-test-integration-consuming")
+
+	// The consumer of the offer appears in the model hosting the offer as
+	// a remote application with a generated name, found when importing
+	// the integration of the offering model.
+	var remoteApplication string
+	variables := config.Variables{
+		"remote_application": lazyStringVariable{value: &remoteApplication},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationSuspended(srcModelName, dstModelName, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.consumer", "suspended", "false"),
+					resource.TestCheckResourceAttr("juju_integration.consumer", "suspended_reason", ""),
+				),
+			},
+			{
+				Config:      testAccResourceIntegrationSuspended(srcModelName, dstModelName, "suspended = true", ""),
+				ExpectError: regexp.MustCompile("an integration consuming an offer cannot be suspended"),
+			},
+			{
+				Config:             testAccResourceIntegrationSuspended(srcModelName, dstModelName, "", testAccOfferingIntegration("")),
+				ConfigVariables:    variables,
+				ResourceName:       "juju_integration.offering",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					modelUUID := s.RootModule().Resources["juju_model.offering"].Primary.Attributes["uuid"]
+					integrations, err := TestClient.Integrations.ListIntegrations(modelUUID)
+					if err != nil {
+						return "", err
+					}
+					for _, apps := range integrations {
+						if apps[0].Name == "offered" {
+							remoteApplication = apps[1].Name
+							return newIDForIntegrationResource(modelUUID, apps), nil
+						}
+					}
+					return "", fmt.Errorf("integration of the offer not found in model %q", modelUUID)
+				},
+			},
+			{
+				Config:          testAccResourceIntegrationSuspended(srcModelName, dstModelName, "", testAccOfferingIntegration(`suspended_reason = "quarantined"`)),
+				ConfigVariables: variables,
+				ExpectError:     regexp.MustCompile(`"suspended_reason" can only be set when "suspended" is true`),
+			},
+			{
+				Config: testAccResourceIntegrationSuspended(srcModelName, dstModelName, "", testAccOfferingIntegration(`
+  suspended        = true
+  suspended_reason = "quarantined"`)),
+				ConfigVariables: variables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.offering", "suspended", "true"),
+					resource.TestCheckResourceAttr("juju_integration.offering", "suspended_reason", "quarantined"),
+				),
+			},
+			{
+				Config: testAccResourceIntegrationSuspended(srcModelName, dstModelName, "", testAccOfferingIntegration(`
+  suspended        = true
+  suspended_reason = "still quarantined"`)),
+				ConfigVariables: variables,
+				ExpectError:     regexp.MustCompile("the reason of a suspended integration cannot be changed"),
+			},
+			{
+				Config:          testAccResourceIntegrationSuspended(srcModelName, dstModelName, "", testAccOfferingIntegration("suspended = false")),
+				ConfigVariables: variables,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.offering", "suspended", "false"),
+					resource.TestCheckResourceAttr("juju_integration.offering", "suspended_reason", ""),
+				),
+			},
+			{
+				// Forget the integration of the offering model, which is
+				// removed with the integration of the consuming model.
+				Config: testAccResourceIntegrationSuspended(srcModelName, dstModelName, "", `
+removed {
+  from = juju_integration.offering
+
+  lifecycle {
+    destroy = false
+  }
+}
+`),
+			},
+		},
+	})
+}
+
+// lazyStringVariable is a config variable whose value is only known once
+// the previous steps of a test have run.
+type lazyStringVariable struct {
+	value *string
+}
+
+// MarshalJSON returns the JSON encoding of the current value.
+func (v lazyStringVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(*v.value)
+}
+
+// testAccOfferingIntegration returns the integration of the model hosting
+// the offer with its consumer, with the given attributes.
+func testAccOfferingIntegration(attributes string) string {
+	return fmt.Sprintf(`
+variable "remote_application" {
+  type = string
+}
+
+resource "juju_integration" "offering" {
+  model_uuid = juju_model.offering.uuid
+  %s
+
+  application {
+    name     = juju_application.offered.name
+    endpoint = "sink"
+  }
+
+  application {
+    name     = var.remote_application
+    endpoint = "source"
+  }
+}
+`, attributes)
+}
+
+func testAccResourceIntegrationSuspended(srcModelName, dstModelName, consumerAttributes, extra string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "offering" {
+  name = %q
+}
+
+resource "juju_application" "offered" {
+  name       = "offered"
+  model_uuid = juju_model.offering.uuid
+
+  charm {
+    name = "juju-qa-dummy-source"
+  }
+  config = {
+    token = "abc"
+  }
+}
+
+resource "juju_offer" "offered" {
+  model_uuid       = juju_model.offering.uuid
+  application_name = juju_application.offered.name
+  endpoints        = ["sink"]
+}
+
+resource "juju_model" "consuming" {
+  name = %q
+}
+
+resource "juju_application" "consumer" {
+  name       = "consumer"
+  model_uuid = juju_model.consuming.uuid
+
+  charm {
+    name = "juju-qa-dummy-sink"
+  }
+}
+
+resource "juju_integration" "consumer" {
+  model_uuid = juju_model.consuming.uuid
+  %s
+
+  application {
+    name     = juju_application.consumer.name
+    endpoint = "source"
+  }
+
+  application {
+    offer_url = juju_offer.offered.url
+  }
+}
+%s`, srcModelName, dstModelName, consumerAttributes, extra)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/canonical/jimm-go-sdk/v3/names"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,6 +22,7 @@ import (
 
 var _ resource.Resource = &jaasGroupResource{}
 var _ resource.ResourceWithConfigure = &jaasGroupResource{}
+var _ resource.ResourceWithImportState = &jaasGroupResource{}
 
 type jaasGroupResource struct {
 	client *juju.Client
@@ -90,6 +92,30 @@ func (resource *jaasGroupResource) Configure(ctx context.Context, req resource.C
 	resource.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASGroup)
 }
 
+// ImportState imports a group by its name or UUID.
+func (resource *jaasGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Check first if the client is configured
+	if resource.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASGroup, "import")
+		return
+	}
+
+	var group *juju.JaasGroup
+	var err error
+	if names.IsValidGroupId(req.ID) {
+		group, err = resource.client.Jaas.ReadGroupByUUID(req.ID)
+	} else {
+		group, err = resource.client.Jaas.ReadGroupByName(req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group %q, got error: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), group.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), group.UUID)...)
+}
+
 // Create attempts to create the group represented by the resource in JAAS.
 func (resource *jaasGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Check first if the client is configured
@@ -48,6 +48,20 @@ func TestAcc_ResourceJaasGroup(t *testing.T) {
 					testAccCheckJaasGroupExists(resourceName, true),
 				),
 			},
+			{
+				ImportStateVerify:                    true,
+				ImportState:                          true,
+				ImportStateId:                        newGroupName,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ResourceName:                         resourceName,
+			},
+			{
+				ImportStateVerify:                    true,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccJaasGroupUUID(resourceName),
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ResourceName:                         resourceName,
+			},
 		},
 	})
 }
@@ -87,3 +101,13 @@ func testAccCheckJaasGroupExists(resourceName string, checkExists bool) resource
 		return nil
 	}
 }
+
+func testAccJaasGroupUUID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Group %q not found", resourceName)
+		}
+		return rs.Primary.Attributes["uuid"], nil
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/canonical/jimm-go-sdk/v3/names"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,6 +22,7 @@ import (
 
 var _ resource.Resource = &jaasRoleResource{}
 var _ resource.ResourceWithConfigure = &jaasRoleResource{}
+var _ resource.ResourceWithImportState = &jaasRoleResource{}
 
 type jaasRoleResource struct {
 	client *juju.Client
@@ -90,6 +92,30 @@ func (resource *jaasRoleResource) Configure(ctx context.Context, req resource.Co
 	resource.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASRole)
 }
 
+// ImportState imports a role by its name or UUID.
+func (resource *jaasRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Check first if the client is configured
+	if resource.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASRole, "import")
+		return
+	}
+
+	var role *juju.JaasRole
+	var err error
+	if names.IsValidRoleId(req.ID) {
+		role, err = resource.client.Jaas.ReadRoleByUUID(req.ID)
+	} else {
+		role, err = resource.client.Jaas.ReadRoleByName(req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get role %q, got error: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), role.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), role.UUID)...)
+}
+
 // Create attempts to create the role represented by the resource in JAAS.
 func (resource *jaasRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Check first if the client is configured
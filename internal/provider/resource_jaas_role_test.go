@@ -48,6 +48,20 @@ func TestAcc_ResourceJaasRole(t *testing.T) {
 					testAccCheckJaasRoleExists(resourceName, true),
 				),
 			},
+			{
+				ImportStateVerify:                    true,
+				ImportState:                          true,
+				ImportStateId:                        newRoleName,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ResourceName:                         resourceName,
+			},
+			{
+				ImportStateVerify:                    true,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccJaasRoleUUID(resourceName),
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ResourceName:                         resourceName,
+			},
 		},
 	})
 }
@@ -87,3 +101,13 @@ func testAccCheckJaasRoleExists(resourceName string, checkExists bool) resource.
 		return nil
 	}
 }
+
+func testAccJaasRoleUUID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Role %q not found", resourceName)
+		}
+		return rs.Primary.Attributes["uuid"], nil
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	jimmnames "github.com/canonical/jimm-go-sdk/v3/names"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ resource.Resource = &jaasServiceAccountResource{}
+var _ resource.ResourceWithConfigure = &jaasServiceAccountResource{}
+var _ resource.ResourceWithConfigValidators = &jaasServiceAccountResource{}
+var _ resource.ResourceWithImportState = &jaasServiceAccountResource{}
+
+type jaasServiceAccountResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+// NewJAASServiceAccountResource returns a new instance of the JAAS service account resource.
+func NewJAASServiceAccountResource() resource.Resource {
+	return &jaasServiceAccountResource{}
+}
+
+type jaasServiceAccountResourceModel struct {
+	ClientID    types.String `tfsdk:"client_id"`
+	Credentials types.Set    `tfsdk:"credentials"`
+
+	// ID required for imports
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata returns the metadata for the JAAS service account resource.
+func (r *jaasServiceAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_service_account"
+}
+
+// ConfigValidators sets validators for the service account resource.
+func (r *jaasServiceAccountResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewRequiresJAASValidator(r.client),
+	}
+}
+
+// Schema defines the schema for JAAS service accounts.
+func (r *jaasServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a service account in JAAS, along with the cloud credentials it may use.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "The client ID of the service account, as created by the external identity provider. " +
+					"If this is changed the resource will be deleted and a new resource will be created.",
+				Required: true,
+				Validators: []validator.String{
+					ValidatorMatchString(func(s string) bool {
+						_, err := jimmnames.EnsureValidServiceAccountId(s)
+						return err == nil
+					}, "client ID must be a valid service account client ID"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"credentials": schema.SetAttribute{
+				Description: "The IDs of `juju_credential` resources to copy to the service account. " +
+					"The credentials must be controller credentials owned by the user running Terraform. " +
+					"JAAS does not support removing a credential from a service account, so a credential " +
+					"removed from this set stays available to the service account.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(ValidatorMatchString(func(s string) bool {
+						_, err := jaasCredentialFromID(s)
+						return err == nil
+					}, "credential must be the ID of a juju_credential resource with controller_credential set")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the JAAS service account resource with the provider data.
+func (r *jaasServiceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = provider.Client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASServiceAccount)
+}
+
+// ImportState imports a service account by its client ID.
+func (r *jaasServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// Create attempts to add the service account represented by the resource
+// to JAAS and to copy its credentials.
+func (r *jaasServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccount, "create")
+		return
+	}
+
+	// Read Terraform configuration from the request into the model
+	var plan jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clientID := plan.ClientID.ValueString()
+
+	// Add the service account to JAAS, making the current user its administrator
+	err := r.client.Jaas.AddServiceAccount(clientID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add service account %q, got error: %s", clientID, err))
+		return
+	}
+	plan.ID = plan.ClientID
+	// Save the service account before copying credentials, so that it is
+	// tracked even if one of the copies fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), plan.ClientID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.copyCredentials(ctx, clientID, plan.Credentials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace(fmt.Sprintf("created service account %q", clientID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read attempts to read the service account represented by the resource
+// from JAAS.
+func (r *jaasServiceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccount, "read")
+		return
+	}
+
+	// Read the Terraform state from the request into the model
+	var state jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clientID := state.ClientID.ValueString()
+
+	existing, err := r.client.Jaas.ReadServiceAccountCredentials(clientID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credentials of service account %q, got error: %s", clientID, err))
+		return
+	}
+
+	// Only the credentials managed by Terraform are tracked, those copied to
+	// the service account by other means are left alone.
+	if !state.Credentials.IsNull() {
+		var ids []string
+		resp.Diagnostics.Append(state.Credentials.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		found := make([]string, 0, len(ids))
+		for _, id := range ids {
+			credential, err := jaasCredentialFromID(id)
+			if err != nil {
+				resp.Diagnostics.AddError("Provider Error", err.Error())
+				return
+			}
+			for _, e := range existing {
+				if e == credential {
+					found = append(found, id)
+					break
+				}
+			}
+		}
+		var diags diag.Diagnostics
+		state.Credentials, diags = types.SetValueFrom(ctx, types.StringType, found)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.trace(fmt.Sprintf("read service account %q", clientID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update attempts to copy credentials added to the resource to the
+// service account in JAAS.
+func (r *jaasServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccount, "update")
+		return
+	}
+
+	// Read the current state from the request
+	var state jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read the plan from the request into the model
+	var plan jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Note the nomenclature of diffSet, it returns the elements of its
+	// first argument which are missing from the second.
+	toAdd := diffSet(plan.Credentials, state.Credentials, &resp.Diagnostics)
+	toRemove := diffSet(state.Credentials, plan.Credentials, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(toRemove.Elements()) > 0 {
+		resp.Diagnostics.AddWarning("Credentials Not Removed",
+			fmt.Sprintf("JAAS does not support removing credentials from a service account, "+
+				"credentials %s remain available to service account %q.", toRemove, plan.ClientID.ValueString()))
+	}
+	resp.Diagnostics.Append(r.copyCredentials(ctx, plan.ClientID.ValueString(), toAdd)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.trace(fmt.Sprintf("updated service account %q", plan.ClientID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the service account from the Terraform state. JAAS
+// offers no way of removing a service account or its credentials.
+func (r *jaasServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASServiceAccount, "delete")
+		return
+	}
+
+	// Read the Terraform state from the request into the model
+	var state jaasServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning("Service Account Not Removed",
+		fmt.Sprintf("JAAS does not support removing service accounts, service account %q and its "+
+			"credentials have only been removed from the Terraform state.", state.ClientID.ValueString()))
+	r.trace(fmt.Sprintf("removed service account %q from state", state.ClientID.ValueString()))
+}
+
+// copyCredentials copies the credentials with the given IDs to the service
+// account, skipping those it already holds.
+func (r *jaasServiceAccountResource) copyCredentials(ctx context.Context, clientID string, ids types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(ids.Elements()) == 0 {
+		return diags
+	}
+	var values []string
+	diags.Append(ids.ElementsAs(ctx, &values, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err := r.client.Jaas.ReadServiceAccountCredentials(clientID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read credentials of service account %q, got error: %s", clientID, err))
+		return diags
+	}
+	credentials := make([]juju.JaasCredential, 0, len(values))
+	for _, id := range values {
+		credential, err := jaasCredentialFromID(id)
+		if err != nil {
+			diags.AddError("Provider Error", err.Error())
+			return diags
+		}
+		held := false
+		for _, e := range existing {
+			if e == credential {
+				held = true
+				break
+			}
+		}
+		if !held {
+			credentials = append(credentials, credential)
+		}
+	}
+	if len(credentials) == 0 {
+		return diags
+	}
+
+	if err := r.client.Jaas.CopyServiceAccountCredentials(clientID, credentials); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to copy credentials to service account %q, got error: %s", clientID, err))
+	}
+	return diags
+}
+
+func (r *jaasServiceAccountResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceJAASServiceAccount, msg, additionalFields...)
+}
+
+// jaasCredentialFromID returns the cloud credential identified by the ID
+// of a juju_credential resource. Only controller credentials are known to
+// JAAS, so the ID of a client-only credential is rejected.
+func jaasCredentialFromID(id string) (juju.JaasCredential, error) {
+	var diags diag.Diagnostics
+	name, cloud, _, controllerCredential := retrieveCredentialDataFromID(id, &diags, "read")
+	if diags.HasError() {
+		return juju.JaasCredential{}, fmt.Errorf("invalid credential ID %q", id)
+	}
+	if !controllerCredential {
+		return juju.JaasCredential{}, fmt.Errorf("credential %q is not a controller credential", id)
+	}
+	return juju.JaasCredential{Cloud: cloud, Name: name}, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestJaasCredentialFromID(t *testing.T) {
+	credential, err := jaasCredentialFromID("cred:aws:false:true")
+	assert.NoError(t, err)
+	assert.Equal(t, juju.JaasCredential{Cloud: "aws", Name: "cred"}, credential)
+
+	_, err = jaasCredentialFromID("cred:aws:true:false")
+	assert.ErrorContains(t, err, "not a controller credential")
+
+	_, err = jaasCredentialFromID("aws/cred")
+	assert.ErrorContains(t, err, "invalid credential ID")
+}
+
+func TestAcc_ResourceJaasServiceAccount(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	clientID := acctest.RandomWithPrefix("tf-jaas-svc-acc")
+	resourceName := "juju_jaas_service_account.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceJaasServiceAccount(clientID, "cred:localhost:true:false"),
+				ExpectError: regexp.MustCompile("credential must be the ID of a juju_credential resource"),
+			},
+			{
+				Config: testAccResourceJaasServiceAccount(clientID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "client_id", clientID),
+					resource.TestCheckResourceAttr(resourceName, "id", clientID),
+					resource.TestCheckNoResourceAttr(resourceName, "credentials"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceJaasServiceAccount(clientID, credentialID string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceJaasServiceAccount",
+		`
+resource "juju_jaas_service_account" "test" {
+  client_id = "{{ .ClientID }}"
+  {{- if .CredentialID }}
+  credentials = ["{{ .CredentialID }}"]
+  {{- end }}
+}
+`, internaltesting.TemplateData{
+			"ClientID":     clientID,
+			"CredentialID": credentialID,
+		})
+}
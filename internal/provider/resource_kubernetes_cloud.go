@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,6 +22,7 @@ import (
 var _ resource.Resource = &kubernetesCloudResource{}
 var _ resource.ResourceWithConfigure = &kubernetesCloudResource{}
 var _ resource.ResourceWithConfigValidators = &kubernetesCloudResource{}
+var _ resource.ResourceWithImportState = &kubernetesCloudResource{}
 
 func NewKubernetesCloudResource() resource.Resource {
 	return &kubernetesCloudResource{}
@@ -158,6 +160,14 @@ func (r *kubernetesCloudResource) Schema(_ context.Context, req resource.SchemaR
 	}
 }
 
+// ImportState imports a kubernetes cloud by its name.
+func (r *kubernetesCloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.AddWarning("Kubernetes Config Not Imported",
+		fmt.Sprintf("The kubernetes_config of cloud %q cannot be read back from the controller. "+
+			"It is left unset in the state, so setting it in the configuration will show as a change on the next plan.", req.ID))
+}
+
 // Create adds a new kubernetes cloud to controllers used now by Terraform provider.
 func (r *kubernetesCloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -235,6 +245,17 @@ func (r *kubernetesCloudResource) Read(ctx context.Context, req resource.ReadReq
 
 	state.CloudName = types.StringValue(readKubernetesCloudOutput.Name)
 	state.CloudCredential = types.StringValue(readKubernetesCloudOutput.CredentialName)
+	// Optional attributes are only filled in when missing from the state,
+	// which happens after an import.
+	if state.ParentCloudName.IsNull() && readKubernetesCloudOutput.ParentCloudName != "" {
+		state.ParentCloudName = types.StringValue(readKubernetesCloudOutput.ParentCloudName)
+	}
+	if state.ParentCloudRegion.IsNull() && readKubernetesCloudOutput.ParentCloudRegion != "" {
+		state.ParentCloudRegion = types.StringValue(readKubernetesCloudOutput.ParentCloudRegion)
+	}
+	if state.StorageClassName.IsNull() && readKubernetesCloudOutput.StorageClassName != "" {
+		state.StorageClassName = types.StringValue(readKubernetesCloudOutput.StorageClassName)
+	}
 	state.ID = types.StringValue(newKubernetesCloudID(readKubernetesCloudOutput.Name, readKubernetesCloudOutput.CredentialName))
 
 	r.trace(fmt.Sprintf("Read kubernetes cloud %s", state.CloudName))
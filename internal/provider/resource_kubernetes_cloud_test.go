@@ -30,6 +30,15 @@ func TestAcc_ResourceKubernetesCloud(t *testing.T) {
 					resource.TestCheckResourceAttr("juju_kubernetes_cloud."+cloudName, "name", cloudName),
 				),
 			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     cloudName,
+				// The kubernetes config cannot be read back from the
+				// controller, nor can whether a service account was created.
+				ImportStateVerifyIgnore: []string{"kubernetes_config", "skip_service_account_creation"},
+				ResourceName:            "juju_kubernetes_cloud." + cloudName,
+			},
 		}})
 }
 
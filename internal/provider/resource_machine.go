@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,20 +31,24 @@ import (
 	"github.com/juju/terraform-provider-juju/internal/wait"
 )
 
-const (
-	defaultCreateTimeout = 30 * time.Minute
-)
-
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &machineResource{}
 var _ resource.ResourceWithConfigure = &machineResource{}
 var _ resource.ResourceWithImportState = &machineResource{}
 var _ resource.ResourceWithUpgradeState = &machineResource{}
+var _ resource.ResourceWithIdentity = &machineResource{}
+var _ list.ListResourceWithConfigure = &machineResource{}
 
 func NewMachineResource() resource.Resource {
 	return &machineResource{}
 }
 
+// NewMachineListResource returns a new instance of the machine list resource,
+// which lists the machines of a model.
+func NewMachineListResource() list.ListResource {
+	return &machineResource{}
+}
+
 type machineResource struct {
 	client *juju.Client
 	config juju.Config
@@ -78,11 +83,17 @@ type machineResourceModelV0 struct {
 
 type machineResourceModelV1 struct {
 	machineResourceModel
-	ModelUUID types.String `tfsdk:"model_uuid"`
+	ModelUUID      types.String `tfsdk:"model_uuid"`
+	DestroyForce   types.Bool   `tfsdk:"destroy_force"`
+	DestroyMaxWait types.String `tfsdk:"destroy_max_wait"`
+	KeepInstance   types.Bool   `tfsdk:"keep_instance"`
 }
 
 func (r *machineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_machine"
+	// The ID of a machine, and so its identity, includes its name which
+	// can be updated.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure enables provider-level data or clients to be set in the
@@ -119,6 +130,9 @@ const (
 	SSHAddressKey     = "ssh_address"
 	PrivateKeyFileKey = "private_key_file"
 	PublicKeyFileKey  = "public_key_file"
+	DestroyForceKey   = "destroy_force"
+	DestroyMaxWaitKey = "destroy_max_wait"
+	KeepInstanceKey   = "keep_instance"
 )
 
 func (r *machineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -274,6 +288,28 @@ func (r *machineResource) Schema(ctx context.Context, req resource.SchemaRequest
 					"A side effect is that this also waits for the machine to reach 'active' state in Juju.",
 				Optional: true,
 			},
+			DestroyForceKey: schema.BoolAttribute{
+				Description: "Whether the machine is forcefully destroyed, ignoring errors, e.g. when the " +
+					"machine agent is not responding because the machine has been powered off.",
+				Optional: true,
+			},
+			DestroyMaxWaitKey: schema.StringAttribute{
+				Description: "How long each step of the forced removal of the machine waits before moving " +
+					"on to the next step, as a duration, e.g. \"5m\". Requires destroy_force to be set.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot(DestroyForceKey)),
+					ValidatorMatchString(func(s string) bool {
+						_, err := time.ParseDuration(s)
+						return err == nil
+					}, "must be a valid duration"),
+				},
+			},
+			KeepInstanceKey: schema.BoolAttribute{
+				Description: "Whether the cloud instance of the machine is kept running when the machine " +
+					"is destroyed. Only the machine is removed from the model.",
+				Optional: true,
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -283,7 +319,14 @@ func (r *machineResource) Schema(ctx context.Context, req resource.SchemaRequest
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
-				Create: true,
+				Create:            true,
+				CreateDescription: timeoutDescription("the machine to be running and, with `wait_for_hostname`, to have a hostname", defaultCreateTimeout),
+				Read:              true,
+				ReadDescription:   timeoutDescription("the machine to be running when it is read", defaultReadTimeout),
+				Update:            true,
+				UpdateDescription: timeoutDescription("the annotations of the machine to be updated", defaultUpdateTimeout),
+				Delete:            true,
+				DeleteDescription: timeoutDescription("the machine to be removed", defaultDeleteTimeout),
 			}),
 		},
 	}
@@ -337,6 +380,7 @@ func (r *machineResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.Name = types.StringValue(machineName)
 	plan.Hostname = types.StringValue("")
 
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -376,6 +420,7 @@ func (r *machineResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.Base = types.StringValue(readResponse.Base)
 	plan.Hostname = types.StringValue(readResponse.Hostname)
 
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -391,6 +436,7 @@ func (r *machineResource) waitForMachine(ctx context.Context, waitForHostname bo
 			ModelUUID: modelUUID,
 			ID:        machineID,
 		},
+		Changes:        func() <-chan struct{} { return r.client.ModelChanged(modelUUID) },
 		DataAssertions: asserts,
 		NonFatalErrors: []error{juju.RetryReadError, juju.ConnectionRefusedError},
 		RetryConf: &wait.RetryConf{
@@ -442,15 +488,22 @@ func (r *machineResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID)...)
 
 	modelUUID, machineID, machineName := modelMachineIDAndName(data.ID.ValueString(), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// During import, we don't know whether to wait for the machine hostname.
 	// So we opt not to wait, assuming the machine is ready.
-	response, err := r.waitForMachine(ctx, false, modelUUID, machineID, defaultCreateTimeout)
+	response, err := r.waitForMachine(ctx, false, modelUUID, machineID, readTimeout)
 	if err != nil {
 		resp.Diagnostics.Append(handleMachineNotFoundError(ctx, err, &resp.State)...)
 		return
@@ -505,29 +558,38 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// TODO hml 28-Jul-2023
 	// Delete the machine resource if it no longer exists in juju.
 
 	// Check annotations
 	if !state.Annotations.Equal(plan.Annotations) {
-		resp.Diagnostics.Append(updateAnnotations(ctx, &r.client.Annotations, state.Annotations, plan.Annotations, state.ModelUUID.ValueString(), names.NewMachineTag(state.MachineID.ValueString()))...)
+		resp.Diagnostics.Append(updateAnnotations(ctx, &r.client.Annotations, state.Annotations, plan.Annotations, state.ModelUUID.ValueString(), names.NewMachineTag(state.MachineID.ValueString()), updateTimeout)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
-	// Only the name or annotations can be updated in the terraform data.
-	if plan.Name.Equal(state.Name) && state.Annotations.Equal(plan.Annotations) {
-		return
-	}
+	// Only the name, annotations, timeouts or destroy options can be updated
+	// in the terraform data.
 	state.Name = plan.Name
 	id := newMachineID(plan.ModelUUID.ValueString(), state.MachineID.ValueString(), plan.Name.ValueString())
 	state.ID = types.StringValue(id)
 	state.Annotations = plan.Annotations
+	state.Timeouts = plan.Timeouts
+	state.DestroyForce = plan.DestroyForce
+	state.DestroyMaxWait = plan.DestroyMaxWait
+	state.KeepInstance = plan.KeepInstance
 
 	r.trace(fmt.Sprintf("update machine resource %q", plan.MachineID.ValueString()))
 
 	// Save updated data into Terraform state
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, state.ID)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -561,13 +623,31 @@ func (r *machineResource) Delete(ctx context.Context, req resource.DeleteRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	defer func() {
 		r.trace(fmt.Sprintf("delete machine resource %q", machineID))
 	}()
 
+	var maxWait *time.Duration
+	if data.DestroyMaxWait.ValueString() != "" {
+		duration, err := time.ParseDuration(data.DestroyMaxWait.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(DestroyMaxWaitKey), "Invalid Max Wait", err.Error())
+			return
+		}
+		maxWait = &duration
+	}
+
 	if err := r.client.Machines.DestroyMachine(&juju.DestroyMachineInput{
 		ModelUUID: modelUUID,
 		ID:        machineID,
+		Force:     data.DestroyForce.ValueBool(),
+		MaxWait:   maxWait,
+		Keep:      data.KeepInstance.ValueBool(),
 	}); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete machine, got error: %s", err))
 		return
@@ -580,12 +660,17 @@ func (r *machineResource) Delete(ctx context.Context, req resource.DeleteRequest
 			ModelUUID: modelUUID,
 			ID:        machineID,
 		},
+		Changes:        func() <-chan struct{} { return r.client.ModelChanged(modelUUID) },
 		ExpectedErr:    juju.MachineNotFoundError,
 		RetryAllErrors: true,
+		RetryConf: &wait.RetryConf{
+			MaxDuration: deleteTimeout,
+		},
 	}); err != nil {
 		errSummary := "Wait Error"
 		errDetail := fmt.Sprintf("Timeout reached waiting for machine %q deletion, got error: %s.\n"+
-			"Make sure no application units or containers are still running on the machine", machineID, err)
+			"Make sure no application units or containers are still running on the machine, "+
+			"or set destroy_force to remove the machine regardless", machineID, err)
 		if r.config.SkipFailedDeletion {
 			resp.Diagnostics.AddWarning(
 				errSummary,
@@ -625,6 +710,13 @@ func (r *machineResource) UpgradeState(ctx context.Context) map[int64]resource.S
 				newID := newMachineID(modelUUID, machineID, machineName)
 				machineV0.ID = types.StringValue(newID)
 
+				var dErr diag.Diagnostics
+				machineV0.Timeouts, dErr = upgradeTimeouts(ctx, machineV0.Timeouts, resp.State.Schema.(schema.Schema))
+				resp.Diagnostics.Append(dErr...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
 				upgradedStateData := machineResourceModelV1{
 					ModelUUID:            types.StringValue(modelUUID),
 					machineResourceModel: machineV0.machineResourceModel,
@@ -643,7 +735,35 @@ func (r *machineResource) UpgradeState(ctx context.Context) map[int64]resource.S
 // If setting an attribute with the import identifier, it is recommended
 // to use the ImportStatePassthroughID() call in this method.
 func (r *machineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// IdentitySchema returns the identity schema of the machine resource.
+func (r *machineResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the machine, of the form `<model UUID>:<machine ID>:<machine name>`.")
+}
+
+// ListResourceConfigSchema returns the schema of the machine list block.
+func (r *machineResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = modelUUIDListSchema("machines")
+}
+
+// List lists the machines of a model.
+func (r *machineResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	listModelResources(ctx, r, r.client, "machine", req, stream, func(modelUUID string) ([]listedResource, error) {
+		machineIDs, err := r.client.Machines.ListMachines(modelUUID)
+		if err != nil {
+			return nil, err
+		}
+		listed := make([]listedResource, 0, len(machineIDs))
+		for _, machineID := range machineIDs {
+			// Listed machines are named as if they were created without
+			// a name.
+			machineName := fmt.Sprintf("machine-%s", machineID)
+			listed = append(listed, listedResource{ID: newMachineID(modelUUID, machineID, machineName), DisplayName: machineName})
+		}
+		return listed, nil
+	})
 }
 
 func (r *machineResource) trace(msg string, additionalFields ...map[string]interface{}) {
@@ -677,8 +797,8 @@ type annotationSetter interface {
 }
 
 // updateAnnotations takes the state and the plan, and performs the necessary
-// steps to propagate the changes to juju.
-func updateAnnotations(ctx context.Context, client annotationSetter, stateAnnotations types.Map, planAnnotations types.Map, modelUUID string, entityTag names.Tag) diag.Diagnostics {
+// steps to propagate the changes to juju within the given timeout.
+func updateAnnotations(ctx context.Context, client annotationSetter, stateAnnotations types.Map, planAnnotations types.Map, modelUUID string, entityTag names.Tag, timeout time.Duration) diag.Diagnostics {
 	diagnostics := diag.Diagnostics{}
 
 	var annotationsState map[string]string
@@ -702,10 +822,12 @@ func updateAnnotations(ctx context.Context, client annotationSetter, stateAnnota
 		}
 	}
 
-	err := client.SetAnnotations(&juju.SetAnnotationsInput{
-		ModelUUID:   modelUUID,
-		Annotations: annotationsPlan,
-		EntityTag:   entityTag,
+	err := retryOnConnectionRefused(ctx, timeout, func() error {
+		return client.SetAnnotations(&juju.SetAnnotationsInput{
+			ModelUUID:   modelUUID,
+			Annotations: annotationsPlan,
+			EntityTag:   entityTag,
+		})
 	})
 	if err != nil {
 		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set annotations for model %q, got error: %s", modelUUID, err))
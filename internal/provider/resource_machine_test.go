@@ -6,6 +6,7 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -29,6 +30,7 @@ func TestAcc_ResourceMachine(t *testing.T) {
 					resource.TestCheckResourceAttrPair("juju_model.this", "uuid", "juju_machine.this", "model_uuid"),
 					resource.TestCheckResourceAttr("juju_machine.this", "name", "this_machine"),
 					resource.TestCheckResourceAttr("juju_machine.this", "base", "ubuntu@22.04"),
+					testAccCheckListed(NewMachineListResource, "juju_machine.this", map[string]string{"model_uuid": "model_uuid"}, "model_uuid", "name", "base"),
 				),
 			},
 			{
@@ -495,3 +497,48 @@ resource "juju_machine" "testmachine" {
   }
 }`, modelName, machineName, annotationKey, annotationValue)
 }
+
+func TestAcc_ResourceMachine_DestroyOptions(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-machine")
+	resourceName := "juju_machine.testmachine"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceMachineDestroyOptions(modelName, `destroy_max_wait = "1m"`),
+				ExpectError: regexp.MustCompile(`Attribute "destroy_force" must be specified when "destroy_max_wait" is\s+specified`),
+			},
+			{
+				Config: testAccResourceMachineDestroyOptions(modelName, `destroy_force = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "destroy_force", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "destroy_max_wait"),
+				),
+			},
+			{
+				Config: testAccResourceMachineDestroyOptions(modelName, `destroy_force = true
+  destroy_max_wait = "1m"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "destroy_max_wait", "1m"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceMachineDestroyOptions(modelName, destroyOptions string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_machine" "testmachine" {
+  model_uuid = juju_model.this.uuid
+  %s
+}
+`, modelName, destroyOptions)
+}
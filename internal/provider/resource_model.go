@@ -10,8 +10,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,14 +36,31 @@ import (
 	"github.com/juju/terraform-provider-juju/internal/wait"
 )
 
+// defaultModelCreateTimeout is the time spent retrying the model creation
+// on transaction errors, unless configured in the timeouts block.
+const defaultModelCreateTimeout = 2 * time.Minute
+
+// secretBackendConfigKey is the model config key of the secret backend
+// of the model, managed by the secret_backend attribute.
+const secretBackendConfigKey = "secret-backend"
+
 var _ resource.Resource = &modelResource{}
 var _ resource.ResourceWithConfigure = &modelResource{}
 var _ resource.ResourceWithImportState = &modelResource{}
+var _ resource.ResourceWithValidateConfig = &modelResource{}
+var _ resource.ResourceWithIdentity = &modelResource{}
+var _ list.ListResourceWithConfigure = &modelResource{}
 
 func NewModelResource() resource.Resource {
 	return &modelResource{}
 }
 
+// NewModelListResource returns a new instance of the model list resource,
+// which lists the models the user has access to.
+func NewModelListResource() list.ListResource {
+	return &modelResource{}
+}
+
 type modelResource struct {
 	client *juju.Client
 	config juju.Config
@@ -55,10 +76,16 @@ type modelResourceModel struct {
 	Constraints types.String `tfsdk:"constraints"`
 	Annotations types.Map    `tfsdk:"annotations"`
 	Credential  types.String `tfsdk:"credential"`
-	Type        types.String `tfsdk:"type"`
-	UUID        types.String `tfsdk:"uuid"`
+	// SecretBackend is the secret backend used to store the
+	// content of the secrets of the model.
+	SecretBackend types.String   `tfsdk:"secret_backend"`
+	Type          types.String   `tfsdk:"type"`
+	UUID          types.String   `tfsdk:"uuid"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
+
+	destroyOptionsModel
 }
 
 // nestedCloud represents an element in a Cloud list of a model resource
@@ -67,7 +94,26 @@ type nestedCloud struct {
 	Region types.String `tfsdk:"region"`
 }
 
-func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+// ValidateConfig checks the secret backend is not set both with the
+// secret_backend attribute and in the model config.
+func (r *modelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData modelResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if configData.SecretBackend.IsNull() || configData.Config.IsUnknown() {
+		return
+	}
+	if _, ok := configData.Config.Elements()[secretBackendConfigKey]; ok {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Attribute Error",
+			fmt.Sprintf("%q cannot be set in config when secret_backend is set.", secretBackendConfigKey))
+	}
+}
+
+func (r *modelResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A resource that represent a Juju Model.",
 		Attributes: map[string]schema.Attribute{
@@ -117,6 +163,15 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"secret_backend": schema.StringAttribute{
+				Description: "The name of the secret backend used to store the content of the secrets of the " +
+					"model, e.g. the name of a juju_secret_backend. This sets the secret-backend model config, " +
+					"which must not also be set in config.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"type": schema.StringAttribute{
 				Description: "Type of the model. Set by the Juju's API server",
 				Computed:    true,
@@ -158,8 +213,21 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: timeoutDescription("the model to be created, retrying transient errors", defaultModelCreateTimeout),
+				Read:              true,
+				ReadDescription:   timeoutDescription("the model to be read", defaultReadTimeout),
+				Update:            true,
+				UpdateDescription: timeoutDescription("the model and its annotations to be updated", defaultUpdateTimeout),
+				Delete:            true,
+				DeleteDescription: timeoutDescription("the model to be destroyed", defaultDeleteTimeout),
+			}),
 		},
 	}
+	for name, attribute := range destroyOptionsAttributes("model") {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (r *modelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -186,7 +254,44 @@ func (r *modelResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 func (r *modelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// IdentitySchema returns the identity schema of the model resource.
+func (r *modelResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the model, which is its UUID.")
+}
+
+// ListResourceConfigSchema returns the schema of the model list block.
+func (r *modelResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the models the user has access to.",
+	}
+}
+
+// List lists the models the user has access to.
+func (r *modelResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	if r.client == nil {
+		addClientNotConfiguredError(&diags, "model", "list")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	models, err := r.client.Models.ListModels()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list models, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	listed := make([]listedResource, 0, len(models))
+	for _, model := range models {
+		listed = append(listed, listedResource{
+			ID:          model.UUID,
+			DisplayName: fmt.Sprintf("%s/%s", model.Owner, model.Name),
+		})
+	}
+	stream.Results = listResults(ctx, r, req, listed)
 }
 
 func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -204,6 +309,12 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultModelCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Acquire modelName, clouds, config, credential & constraints from the model plan
 	modelName := plan.Name.ValueString()
 	var clouds []nestedCloud
@@ -211,13 +322,17 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var config map[string]string
-	var diags diag.Diagnostics
-	config, diags = newConfig(ctx, plan.Config)
+	config, diags := newConfig(ctx, plan.Config)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	if !plan.SecretBackend.IsNull() {
+		if config == nil {
+			config = map[string]string{}
+		}
+		config[secretBackendConfigKey] = plan.SecretBackend.ValueString()
+	}
 	credential := plan.Credential.ValueString()
 	readConstraints := plan.Constraints.ValueString()
 
@@ -253,7 +368,7 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 		Do:              r.client.Models.CreateModel,
 		RetriableErrors: []error{juju.TransactionError, juju.ConnectionRefusedError},
 		RetryConf: &wait.RetryConf{
-			MaxDuration: 2 * time.Minute,
+			MaxDuration: createTimeout,
 			Delay:       time.Second,
 			Clock:       clock.WallClock,
 		},
@@ -304,6 +419,7 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 	r.trace(fmt.Sprintf("model resource created: %q", modelName))
 
 	// Write the state plan into the Response.State
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -321,6 +437,7 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, state.ID)...)
 
 	// Check if the model was imported. If the model was imported
 	// we store model details like the model's cloud and constraints.
@@ -330,9 +447,20 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// This allows a user to create models without specifying a cloud
 	// or constraints, relying on the controller to choose defaults.
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	imported := state.UUID.ValueString() == "" && state.ID.ValueString() != ""
 	modelUUID := state.ID.ValueString()
-	response, err := r.client.Models.ReadModel(modelUUID)
+	var response *juju.ReadModelResponse
+	err := retryOnConnectionRefused(ctx, readTimeout, func() error {
+		var err error
+		response, err = r.client.Models.ReadModel(modelUUID)
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.Append(handleModelNotFoundError(ctx, err, &resp.State)...)
 		return
@@ -369,6 +497,13 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.Constraints = types.StringValue(response.ModelConstraints.String())
 	}
 
+	// Secret backend
+	if !state.SecretBackend.IsNull() {
+		if backend, ok := response.ModelConfig[secretBackendConfigKey].(string); ok {
+			state.SecretBackend = types.StringValue(backend)
+		}
+	}
+
 	// Config
 	if len(response.ModelConfig) > 0 {
 		config, diags := newConfigFromModelConfigAPI(ctx, response.ModelConfig, state.Config)
@@ -427,6 +562,12 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var err error
 	modelUpdate := false
 
@@ -444,6 +585,19 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	// Check the secret backend, it is set with the model config.
+	if !plan.SecretBackend.Equal(state.SecretBackend) {
+		modelUpdate = true
+		if configMap == nil {
+			configMap = map[string]string{}
+		}
+		if plan.SecretBackend.IsNull() {
+			unsetConfigKeys = append(unsetConfigKeys, secretBackendConfigKey)
+		} else {
+			configMap[secretBackendConfigKey] = plan.SecretBackend.ValueString()
+		}
+	}
+
 	// Check the constraints
 	newConstraints, err := constraints.Parse(state.Constraints.ValueString())
 	if err != nil {
@@ -468,7 +622,7 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Check annotations
 	if !state.Annotations.Equal(plan.Annotations) {
-		resp.Diagnostics.Append(updateAnnotations(ctx, &r.client.Annotations, state.Annotations, plan.Annotations, plan.UUID.ValueString(), names.NewModelTag(plan.UUID.ValueString()))...)
+		resp.Diagnostics.Append(updateAnnotations(ctx, &r.client.Annotations, state.Annotations, plan.Annotations, plan.UUID.ValueString(), names.NewModelTag(plan.UUID.ValueString()), updateTimeout)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -487,13 +641,15 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 			cloudNameInput = clouds[0].Name.ValueString()
 		}
 
-		err = r.client.Models.UpdateModel(juju.UpdateModelInput{
-			UUID:        plan.UUID.ValueString(),
-			CloudName:   cloudNameInput,
-			Config:      configMap,
-			Unset:       unsetConfigKeys,
-			Constraints: &newConstraints,
-			Credential:  credentialUpdate,
+		err = retryOnConnectionRefused(ctx, updateTimeout, func() error {
+			return r.client.Models.UpdateModel(juju.UpdateModelInput{
+				UUID:        plan.UUID.ValueString(),
+				CloudName:   cloudNameInput,
+				Config:      configMap,
+				Unset:       unsetConfigKeys,
+				Constraints: &newConstraints,
+				Credential:  credentialUpdate,
+			})
 		})
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update model, got error: %s", err))
@@ -503,6 +659,7 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		r.trace(fmt.Sprintf("Updated model resource: %q", plan.Name.ValueString()))
 	}
 
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -521,15 +678,29 @@ func (r *modelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	modelName := state.Name.ValueString()
 	modelUUID := state.UUID.ValueString()
 	if modelUUID == "" {
 		modelUUID = state.ID.ValueString()
 	}
+	destroyStorage, force, maxWait, err := state.destroyArgs()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(MaxWaitKey), "Invalid Max Wait", err.Error())
+		return
+	}
 	arg := juju.DestroyModelInput{
-		UUID: modelUUID,
+		UUID:           modelUUID,
+		DestroyStorage: destroyStorage,
+		Force:          force,
+		MaxWait:        maxWait,
 	}
-	err := r.client.Models.DestroyModel(arg)
+	err = r.client.Models.DestroyModel(arg)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete model, got error: %s", err))
 		return
@@ -540,6 +711,9 @@ func (r *modelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		Input:          modelUUID,
 		ExpectedErr:    juju.ModelNotFoundError,
 		RetryAllErrors: true,
+		RetryConf: &wait.RetryConf{
+			MaxDuration: deleteTimeout,
+		},
 	})
 	if err != nil {
 		errSummary := "Client Error"
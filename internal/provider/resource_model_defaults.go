@@ -0,0 +1,338 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &modelDefaultsResource{}
+var _ resource.ResourceWithConfigure = &modelDefaultsResource{}
+var _ resource.ResourceWithImportState = &modelDefaultsResource{}
+
+// NewModelDefaultsResource returns a new instance of the model defaults
+// resource.
+func NewModelDefaultsResource() resource.Resource {
+	return &modelDefaultsResource{}
+}
+
+type modelDefaultsResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type modelDefaultsResourceModel struct {
+	Cloud  types.String `tfsdk:"cloud"`
+	Region types.String `tfsdk:"region"`
+	Config types.Map    `tfsdk:"config"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Configure is used to configure the model defaults resource.
+func (r *modelDefaultsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = provider.Client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceModelDefaults)
+}
+
+// Metadata returns the metadata for the model defaults resource.
+func (r *modelDefaultsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_defaults"
+}
+
+// Schema returns the schema for the model defaults resource.
+func (r *modelDefaultsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the model defaults of a cloud, or of a region of a cloud. " +
+			"The defaults are used as the config of every model of the cloud or region, unless the model " +
+			"sets the key in its own config.",
+		Attributes: map[string]schema.Attribute{
+			"cloud": schema.StringAttribute{
+				Description: "The name of the cloud to set the model defaults of. Changing this value will " +
+					"cause the model defaults to be unset and set again by terraform.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The name of the region of the cloud to set the model defaults of. The defaults " +
+					"of the cloud itself are set if not specified. Changing this value will cause the model " +
+					"defaults to be unset and set again by terraform.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.MapAttribute{
+				Description: "The model defaults to set, e.g. proxies or apt mirrors. Keys removed from the map " +
+					"are unset. Changes made outside of terraform are only detected for keys set here. " +
+					"See https://juju.is/docs/juju/configuration for the available keys.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ImportState imports the model defaults of a cloud, with an ID of the
+// form <cloud>, or of a region, with an ID of the form <cloud>:<region>.
+// All the defaults set for the cloud or region are imported.
+func (r *modelDefaultsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cloud, region, err := modelDefaultsIDParts(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cloud"), cloud)...)
+	if region != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// Create sets the model defaults.
+func (r *modelDefaultsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model_defaults", "create")
+		return
+	}
+
+	var plan modelDefaultsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := newConfig(ctx, plan.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Models.SetModelDefaults(juju.SetModelDefaultsInput{
+		ModelDefaultsInput: plan.modelDefaultsInput(),
+		Config:             config,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set model defaults, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(newModelDefaultsID(plan.Cloud.ValueString(), plan.Region.ValueString()))
+	r.trace(fmt.Sprintf("set model defaults %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the model defaults set for the cloud or region.
+func (r *modelDefaultsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model_defaults", "read")
+		return
+	}
+
+	var state modelDefaultsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Models.ReadModelDefaults(state.modelDefaultsInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model defaults, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read model defaults %q", state.ID.ValueString()))
+
+	var stateConfig map[string]string
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config, diags := types.MapValueFrom(ctx, types.StringType, modelDefaultsFromAPI(response.Config, stateConfig))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Config = config
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update sets the changed model defaults and unsets the removed ones.
+func (r *modelDefaultsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model_defaults", "update")
+		return
+	}
+
+	var plan, state modelDefaultsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, unset, diags := computeConfigDiff(ctx, state.Config, plan.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Models.UnsetModelDefaults(juju.UnsetModelDefaultsInput{
+		ModelDefaultsInput: plan.modelDefaultsInput(),
+		Keys:               unset,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unset model defaults, got error: %s", err))
+		return
+	}
+	err = r.client.Models.SetModelDefaults(juju.SetModelDefaultsInput{
+		ModelDefaultsInput: plan.modelDefaultsInput(),
+		Config:             config,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set model defaults, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("updated model defaults %q", plan.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete unsets the model defaults managed by the resource.
+func (r *modelDefaultsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "model_defaults", "delete")
+		return
+	}
+
+	var state modelDefaultsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := newConfig(ctx, state.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+
+	err := r.client.Models.UnsetModelDefaults(juju.UnsetModelDefaultsInput{
+		ModelDefaultsInput: state.modelDefaultsInput(),
+		Keys:               keys,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unset model defaults, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("unset model defaults %q", state.ID.ValueString()))
+}
+
+func (r *modelDefaultsResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+	tflog.SubsystemTrace(r.subCtx, LogResourceModelDefaults, msg, additionalFields...)
+}
+
+func (m modelDefaultsResourceModel) modelDefaultsInput() juju.ModelDefaultsInput {
+	return juju.ModelDefaultsInput{
+		CloudName:   m.Cloud.ValueString(),
+		CloudRegion: m.Region.ValueString(),
+	}
+}
+
+// modelDefaultsFromAPI returns the model defaults read from the
+// controller for the keys in the state. All the defaults are returned
+// when the state has none, i.e. when the resource is imported.
+func modelDefaultsFromAPI(apiConfig map[string]interface{}, stateConfig map[string]string) map[string]string {
+	config := make(map[string]string)
+	for key, value := range apiConfig {
+		if _, ok := stateConfig[key]; ok || stateConfig == nil {
+			config[key] = fmt.Sprint(value)
+		}
+	}
+	return config
+}
+
+func newModelDefaultsID(cloud, region string) string {
+	if region == "" {
+		return cloud
+	}
+	return fmt.Sprintf("%s:%s", cloud, region)
+}
+
+// modelDefaultsIDParts returns the cloud and the region, which may be
+// empty, from an ID of the form <cloud> or <cloud>:<region>.
+func modelDefaultsIDParts(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) > 2 || parts[0] == "" || (len(parts) == 2 && parts[1] == "") {
+		return "", "", fmt.Errorf("expected identifier with format <cloud> or <cloud>:<region>, got %q", id)
+	}
+	if len(parts) == 1 {
+		return parts[0], "", nil
+	}
+	return parts[0], parts[1], nil
+}
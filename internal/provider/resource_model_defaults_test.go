@@ -0,0 +1,98 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelDefaultsFromAPI(t *testing.T) {
+	apiConfig := map[string]interface{}{
+		"ftp-proxy":                 "http://proxy.example.com:3128",
+		"apt-mirror":                "http://mirror.example.com",
+		"automatically-retry-hooks": false,
+	}
+
+	// Only the keys in state are refreshed.
+	assert.Equal(t, map[string]string{
+		"ftp-proxy":                 "http://proxy.example.com:3128",
+		"automatically-retry-hooks": "false",
+	}, modelDefaultsFromAPI(apiConfig, map[string]string{
+		"ftp-proxy":                 "http://old.example.com:3128",
+		"automatically-retry-hooks": "true",
+		"http-proxy":                "http://proxy.example.com:3128",
+	}))
+
+	// All the keys are imported.
+	assert.Equal(t, map[string]string{
+		"ftp-proxy":                 "http://proxy.example.com:3128",
+		"apt-mirror":                "http://mirror.example.com",
+		"automatically-retry-hooks": "false",
+	}, modelDefaultsFromAPI(apiConfig, nil))
+}
+
+func TestModelDefaultsIDParts(t *testing.T) {
+	cloud, region, err := modelDefaultsIDParts("aws")
+	assert.NoError(t, err)
+	assert.Equal(t, "aws", cloud)
+	assert.Equal(t, "", region)
+
+	cloud, region, err = modelDefaultsIDParts("aws:us-east-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "aws", cloud)
+	assert.Equal(t, "us-east-1", region)
+
+	for _, id := range []string{"", ":us-east-1", "aws:", "aws:us-east-1:extra"} {
+		_, _, err := modelDefaultsIDParts(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestAcc_ResourceModelDefaults(t *testing.T) {
+	cloudName := testingCloud.CloudName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelDefaults(cloudName, `"ftp-proxy" = "http://proxy.example.com:3128"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model_defaults.this", "cloud", cloudName),
+					resource.TestCheckResourceAttr("juju_model_defaults.this", "id", cloudName),
+					resource.TestCheckResourceAttr("juju_model_defaults.this", "config.ftp-proxy", "http://proxy.example.com:3128"),
+				),
+			},
+			{
+				Config: testAccResourceModelDefaults(cloudName, `"no-proxy" = "127.0.0.1,localhost"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model_defaults.this", "config.%", "1"),
+					resource.TestCheckResourceAttr("juju_model_defaults.this", "config.no-proxy", "127.0.0.1,localhost"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportStateId:     cloudName,
+				ImportState:       true,
+				ResourceName:      "juju_model_defaults.this",
+			},
+		},
+	})
+}
+
+func testAccResourceModelDefaults(cloudName, config string) string {
+	return fmt.Sprintf(`
+resource "juju_model_defaults" "this" {
+  cloud = %q
+
+  config = {
+    %s
+  }
+}
+`, cloudName, config)
+}
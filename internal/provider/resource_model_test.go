@@ -33,6 +33,7 @@ func TestAcc_ResourceModel(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", modelName),
 					resource.TestCheckResourceAttr(resourceName, "config.logging-config", fmt.Sprintf("<root>=%s", logLevelInfo)),
 					resource.TestMatchResourceAttr(resourceName, "uuid", validUUID),
+					testAccCheckListed(NewModelListResource, resourceName, nil, "name", "uuid"),
 				),
 			},
 			{
@@ -183,6 +184,42 @@ resource "juju_model" "testmodel" {
 	})
 }
 
+func TestAcc_ResourceModel_Timeouts(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelTimeouts(modelName, "5m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model.testmodel", "timeouts.create", "5m"),
+					resource.TestCheckResourceAttr("juju_model.testmodel", "timeouts.delete", "20m"),
+				),
+			},
+			{
+				// Only the timeouts are updated.
+				Config: testAccResourceModelTimeouts(modelName, "10m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model.testmodel", "timeouts.create", "10m"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceModelTimeouts(modelName, createTimeout string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name = %q
+
+  timeouts {
+    create = %q
+    delete = "20m"
+  }
+}`, modelName, createTimeout)
+}
+
 func TestAcc_ResourceModel_UpgradeProvider(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-model")
 	logLevelDebug := "DEBUG"
@@ -302,6 +339,94 @@ func TestAcc_ResourceModel_WaitForDelete(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceModel_SecretBackend(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name           = %q
+  secret_backend = "internal"
+
+  config = {
+    secret-backend = "auto"
+  }
+}`, modelName),
+				ExpectError: regexp.MustCompile(`"secret-backend" cannot be set in config when secret_backend is set`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name           = %q
+  secret_backend = "internal"
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model.testmodel", "secret_backend", "internal"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name = %q
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("juju_model.testmodel", "secret_backend"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceModel_DestroyOptions(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name    = %q
+  no_wait = true
+}`, modelName),
+				ExpectError: regexp.MustCompile(`Attribute "force" must be specified when "no_wait" is specified`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name            = %q
+  destroy_storage = false
+  force           = true
+  max_wait        = "1m"
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model.testmodel", "destroy_storage", "false"),
+					resource.TestCheckResourceAttr("juju_model.testmodel", "max_wait", "1m"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name            = %q
+  destroy_storage = false
+  force           = true
+  no_wait         = true
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model.testmodel", "no_wait", "true"),
+					resource.TestCheckNoResourceAttr("juju_model.testmodel", "max_wait"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDevelopmentConfigIsUnset(resourceID string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceID]
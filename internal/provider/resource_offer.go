@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,11 +31,19 @@ var _ resource.Resource = &offerResource{}
 var _ resource.ResourceWithConfigure = &offerResource{}
 var _ resource.ResourceWithImportState = &offerResource{}
 var _ resource.ResourceWithUpgradeState = &offerResource{}
+var _ resource.ResourceWithIdentity = &offerResource{}
+var _ list.ListResourceWithConfigure = &offerResource{}
 
 func NewOfferResource() resource.Resource {
 	return &offerResource{}
 }
 
+// NewOfferListResource returns a new instance of the offer list resource,
+// which lists the offers of a model.
+func NewOfferListResource() list.ListResource {
+	return &offerResource{}
+}
+
 type offerResource struct {
 	client *juju.Client
 
@@ -63,15 +73,16 @@ type offerResourceModelV1 struct {
 
 type offerResourceModelV2 struct {
 	offerResourceModel
-	ModelUUID types.String `tfsdk:"model_uuid"`
-	Endpoints types.Set    `tfsdk:"endpoints"`
+	ModelUUID types.String   `tfsdk:"model_uuid"`
+	Endpoints types.Set      `tfsdk:"endpoints"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (o *offerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_offer"
 }
 
-func (o *offerResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (o *offerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version:     2,
 		Description: "A resource that represent a Juju Offer.",
@@ -129,6 +140,18 @@ func (o *offerResource) Schema(_ context.Context, req resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: timeoutDescription("the application to be available before offering it", juju.OfferAppAvailableTimeout),
+				Read:              true,
+				ReadDescription:   timeoutDescription("the offer to be read", defaultReadTimeout),
+				Update:            true,
+				UpdateDescription: "Not used, as updating an offer only saves its timeouts.",
+				Delete:            true,
+				DeleteDescription: timeoutDescription("the offer to be removed", defaultDeleteTimeout),
+			}),
+		},
 	}
 }
 
@@ -156,18 +179,24 @@ func (o *offerResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	var endpoints []string
-	diag := plan.Endpoints.ElementsAs(ctx, &endpoints, false)
-	if diag.HasError() {
-		resp.Diagnostics.Append(diag...)
+	resp.Diagnostics.Append(plan.Endpoints.ElementsAs(ctx, &endpoints, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, juju.OfferAppAvailableTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	response, errs := o.client.Offers.CreateOffer(&juju.CreateOfferInput{
-		ModelUUID:       modelUUID,
-		Name:            offerName,
-		ApplicationName: plan.ApplicationName.ValueString(),
-		Endpoints:       endpoints,
-		OfferOwner:      o.client.Username(),
+		ModelUUID:           modelUUID,
+		Name:                offerName,
+		ApplicationName:     plan.ApplicationName.ValueString(),
+		Endpoints:           endpoints,
+		OfferOwner:          o.client.Username(),
+		AppAvailableTimeout: createTimeout,
 	})
 	if errs != nil {
 		// TODO 10-Aug-2023
@@ -188,6 +217,7 @@ func (o *offerResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.ID = types.StringValue(response.OfferURL)
 
 	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, plan.ID)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -204,9 +234,21 @@ func (o *offerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	response, err := o.client.Offers.ReadOffer(&juju.ReadOfferInput{
-		OfferURL:     state.ID.ValueString(),
-		GetModelUUID: true,
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, state.ID)...)
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response *juju.ReadOfferResponse
+	err := retryOnConnectionRefused(ctx, readTimeout, func() error {
+		var err error
+		response, err = o.client.Offers.ReadOffer(&juju.ReadOfferInput{
+			OfferURL:     state.ID.ValueString(),
+			GetModelUUID: true,
+		})
+		return err
 	})
 	if err != nil {
 		resp.Diagnostics.Append(handleOfferNotFoundError(ctx, err, &resp.State)...)
@@ -230,8 +272,17 @@ func (o *offerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// Update only saves the timeouts, as all other fields force replacement.
 func (o *offerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Everything is always replaced, so Update should not be called.
+	var plan, state offerResourceModelV2
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, state.ID)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
@@ -257,8 +308,16 @@ func (o *offerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := o.client.Offers.DestroyOffer(&juju.DestroyOfferInput{
-		OfferURL: plan.URL.ValueString(),
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retryOnConnectionRefused(ctx, deleteTimeout, func() error {
+		return o.client.Offers.DestroyOffer(&juju.DestroyOfferInput{
+			OfferURL: plan.URL.ValueString(),
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete offer, got error: %s", err))
@@ -290,7 +349,32 @@ func (o *offerResource) Configure(ctx context.Context, req resource.ConfigureReq
 // ImportState imports the resource state from the given ID.
 // The ID is expected to be `offer_url`.
 func (o *offerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// IdentitySchema returns the identity schema of the offer resource.
+func (o *offerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the offer, which is its offer URL.")
+}
+
+// ListResourceConfigSchema returns the schema of the offer list block.
+func (o *offerResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = modelUUIDListSchema("offers")
+}
+
+// List lists the offers of a model.
+func (o *offerResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	listModelResources(ctx, o, o.client, "offer", req, stream, func(modelUUID string) ([]listedResource, error) {
+		offerURLs, err := o.client.Offers.ListOffers(modelUUID)
+		if err != nil {
+			return nil, err
+		}
+		listed := make([]listedResource, 0, len(offerURLs))
+		for _, offerURL := range offerURLs {
+			listed = append(listed, listedResource{ID: offerURL, DisplayName: offerURL})
+		}
+		return listed, nil
+	})
 }
 
 func (o *offerResource) trace(msg string, additionalFields ...map[string]interface{}) {
@@ -395,6 +479,7 @@ func (o *offerResource) offerV1ToV2(offerV1 offerResourceModelV1, resp *resource
 	return offerResourceModelV2{
 		ModelUUID:          types.StringValue(modelUUID),
 		Endpoints:          offerV1.Endpoints,
+		Timeouts:           nullTimeouts(resp.State.Schema.(schema.Schema)),
 		offerResourceModel: offerV1.offerResourceModel,
 	}
 }
@@ -29,6 +29,7 @@ func TestAcc_ResourceOffer(t *testing.T) {
 					resource.TestCheckResourceAttrPair("juju_model.this", "uuid", "juju_offer.this", "model_uuid"),
 					resource.TestCheckResourceAttr("juju_offer.this", "url", fmt.Sprintf("%v/%v.%v", expectedResourceOwner(), modelName, "this")),
 					resource.TestCheckResourceAttr("juju_offer.this", "id", fmt.Sprintf("%v/%v.%v", expectedResourceOwner(), modelName, "this")),
+					testAccCheckListed(NewOfferListResource, "juju_offer.this", map[string]string{"model_uuid": "model_uuid"}, "model_uuid", "url"),
 				),
 			},
 			{
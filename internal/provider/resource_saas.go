@@ -0,0 +1,364 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/wait"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &saasResource{}
+var _ resource.ResourceWithConfigure = &saasResource{}
+var _ resource.ResourceWithImportState = &saasResource{}
+var _ resource.ResourceWithValidateConfig = &saasResource{}
+
+// NewSAASResource returns a new instance of the SAAS resource.
+func NewSAASResource() resource.Resource {
+	return &saasResource{}
+}
+
+type saasResource struct {
+	client *juju.Client
+	config juju.Config
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type saasResourceModel struct {
+	ModelUUID       types.String   `tfsdk:"model_uuid"`
+	OfferURL        types.String   `tfsdk:"offer_url"`
+	Alias           types.String   `tfsdk:"alias"`
+	Name            types.String   `tfsdk:"name"`
+	OfferController types.List     `tfsdk:"offer_controller"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Configure is used to configure the SAAS resource.
+func (r *saasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = provider.Client
+	r.config = provider.Config
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceSAAS)
+}
+
+// Metadata returns the metadata for the SAAS resource.
+func (r *saasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saas"
+}
+
+// Schema returns the schema for the SAAS resource.
+func (r *saasResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents an offer consumed by a model, shown under the SAAS heading " +
+			"of `juju status`. The consumed offer can be integrated with the applications of the model by " +
+			"using its name in `juju_integration` resources.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The UUID of the model consuming the offer. Changing this value will cause the " +
+					"SAAS to be destroyed and recreated by terraform.",
+				Required: true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_url": schema.StringAttribute{
+				Description: "The URL of the offer to consume. To consume an offer hosted on another " +
+					"controller, prefix the URL with the name of that controller, e.g. " +
+					"\"controller:admin/model.offer\". Changing this value to another offer will cause the SAAS " +
+					"to be destroyed and recreated by terraform.",
+				Required: true,
+				Validators: []validator.String{
+					ValidatorMatchString(func(s string) bool {
+						url, err := crossmodel.ParseOfferURL(s)
+						return err == nil && !url.HasEndpoint()
+					}, "must be a valid offer URL without endpoint"),
+				},
+				PlanModifiers: []planmodifier.String{
+					// Juju may record the offer URL with or without the
+					// name of the controller hosting the offer, e.g. after
+					// an import, which does not change the consumed offer.
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !sameOfferIgnoringSource(req.PlanValue.ValueString(), req.StateValue.ValueString())
+					}, "Replaces the SAAS if the offer changes.", "Replaces the SAAS if the offer changes."),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Description: "The name to give to the consumed offer in the model. Defaults to the name of " +
+					"the offer. Changing this value will cause the SAAS to be destroyed and recreated by terraform.",
+				Optional: true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidApplication, "must be a valid application name"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the consumed offer in the model, to use in `juju_integration` resources.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			OfferControllerKey: offerControllerBlock("SAAS"),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Delete:            true,
+				DeleteDescription: timeoutDescription("the consumed offer to be removed", defaultDeleteTimeout),
+			}),
+		},
+	}
+}
+
+// ValidateConfig checks that the offer controller is only given for
+// offers hosted on another controller.
+func (r *saasResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config saasResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.OfferURL.IsUnknown() || config.OfferController.IsUnknown() {
+		return
+	}
+	if len(config.OfferController.Elements()) > 0 && !offerURLHasSource(config.OfferURL.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root(OfferControllerKey), "Attribute Error",
+			"\"offer_controller\" requires an \"offer_url\" prefixed with the name of the controller hosting the offer, e.g. \"controller:admin/model.offer\".")
+	}
+}
+
+// ImportState imports a SAAS with an ID of the form <model_uuid>:<name>.
+func (r *saasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	modelUUID, name, err := saasIDParts(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model_uuid"), modelUUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// Create consumes the offer in the model.
+func (r *saasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "saas", "create")
+		return
+	}
+
+	var plan saasResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offerController, diags := sourceController(ctx, plan.OfferController)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Offers.ConsumeRemoteOffer(&juju.ConsumeRemoteOfferInput{
+		ModelUUID:        plan.ModelUUID.ValueString(),
+		OfferURL:         plan.OfferURL.ValueString(),
+		RemoteAppAlias:   plan.Alias.ValueString(),
+		SourceController: offerController,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to consume offer, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("consumed offer %q as %q", plan.OfferURL.ValueString(), response.SAASName))
+
+	plan.Name = types.StringValue(response.SAASName)
+	plan.ID = types.StringValue(newSAASID(plan.ModelUUID.ValueString(), response.SAASName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the current state of the SAAS.
+func (r *saasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "saas", "read")
+		return
+	}
+
+	var state saasResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Offers.ReadRemoteApp(&juju.ReadRemoteAppInput{
+		ModelUUID:     state.ModelUUID.ValueString(),
+		RemoteAppName: state.Name.ValueString(),
+	})
+	if errors.Is(err, juju.RemoteAppNotFoundError) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SAAS, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read SAAS %q", state.Name.ValueString()))
+
+	// The offer URL may be read with or without the name of the
+	// controller hosting the offer, keep the one in state.
+	if !sameOfferIgnoringSource(state.OfferURL.ValueString(), response.OfferURL) {
+		state.OfferURL = types.StringValue(response.OfferURL)
+	}
+	// The name only differs from the name of the offer if an alias was
+	// given, e.g. when importing the SAAS.
+	if url, err := crossmodel.ParseOfferURL(response.OfferURL); err == nil && url.ApplicationName != state.Name.ValueString() {
+		state.Alias = state.Name
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only saves the timeouts, the offer controller and the offer URL
+// when only the name of the controller hosting the offer changed, as all
+// other changes force replacement.
+func (r *saasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state saasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.OfferURL = plan.OfferURL
+	state.Timeouts = plan.Timeouts
+	state.OfferController = plan.OfferController
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete removes the SAAS from the model, along with its remaining
+// integrations.
+func (r *saasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "saas", "delete")
+		return
+	}
+
+	var state saasResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelUUID := state.ModelUUID.ValueString()
+	err := r.client.Offers.RemoveRemoteApp(&juju.RemoveRemoteAppInput{
+		ModelUUID:     modelUUID,
+		RemoteAppName: state.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove SAAS, got error: %s", err))
+		return
+	}
+
+	err = wait.WaitForError(
+		wait.WaitForErrorCfg[*juju.ReadRemoteAppInput, *juju.ReadRemoteAppResponse]{
+			Context: ctx,
+			GetData: r.client.Offers.ReadRemoteApp,
+			Input: &juju.ReadRemoteAppInput{
+				ModelUUID:     modelUUID,
+				RemoteAppName: state.Name.ValueString(),
+			},
+			Changes:        func() <-chan struct{} { return r.client.ModelChanged(modelUUID) },
+			ExpectedErr:    juju.RemoteAppNotFoundError,
+			RetryAllErrors: true,
+			RetryConf: &wait.RetryConf{
+				MaxDuration: deleteTimeout,
+			},
+		},
+	)
+	if err != nil {
+		errSummary := "Client Error"
+		errDetail := fmt.Sprintf("Unable to complete SAAS %q deletion in model %q: %v\n", state.Name.ValueString(), modelUUID, err)
+		if r.config.SkipFailedDeletion {
+			resp.Diagnostics.AddWarning(
+				errSummary,
+				errDetail+"There might be dangling resources requiring manual intervion.\n",
+			)
+		} else {
+			resp.Diagnostics.AddError(errSummary, errDetail)
+			return
+		}
+	}
+	r.trace(fmt.Sprintf("removed SAAS %q", state.Name.ValueString()))
+}
+
+func (r *saasResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+	tflog.SubsystemTrace(r.subCtx, LogResourceSAAS, msg, additionalFields...)
+}
+
+func newSAASID(modelUUID, name string) string {
+	return fmt.Sprintf("%s:%s", modelUUID, name)
+}
+
+// saasIDParts returns the model UUID and the name of the SAAS from an
+// ID of the form <model_uuid>:<name>.
+func saasIDParts(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || !names.IsValidModel(parts[0]) || !names.IsValidApplication(parts[1]) {
+		return "", "", fmt.Errorf("expected identifier with format <model_uuid>:<saas_name>, got %q", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func TestSAASIDParts(t *testing.T) {
+	modelUUID, name, err := saasIDParts("6b0b4bd5-5f64-4c4f-9fa5-6e0a5b9f1d4c:postgresql")
+	assert.NoError(t, err)
+	assert.Equal(t, "6b0b4bd5-5f64-4c4f-9fa5-6e0a5b9f1d4c", modelUUID)
+	assert.Equal(t, "postgresql", name)
+
+	for _, id := range []string{
+		"",
+		"postgresql",
+		"not-a-uuid:postgresql",
+		"6b0b4bd5-5f64-4c4f-9fa5-6e0a5b9f1d4c:",
+		"6b0b4bd5-5f64-4c4f-9fa5-6e0a5b9f1d4c:postgresql:extra",
+	} {
+		_, _, err := saasIDParts(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestSameOfferIgnoringSource(t *testing.T) {
+	assert.True(t, sameOfferIgnoringSource("admin/db.postgresql", "admin/db.postgresql"))
+	assert.True(t, sameOfferIgnoringSource("other:admin/db.postgresql", "admin/db.postgresql"))
+	assert.True(t, sameOfferIgnoringSource("admin/db.postgresql", "other:admin/db.postgresql"))
+	assert.True(t, sameOfferIgnoringSource("other:admin/db.postgresql", "other:admin/db.postgresql"))
+	assert.False(t, sameOfferIgnoringSource("other:admin/db.postgresql", "admin/db.mysql"))
+	assert.False(t, sameOfferIgnoringSource("", "admin/db.postgresql"))
+}
+
+func TestAcc_ResourceSAAS(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	srcModelName := acctest.RandomWithPrefix("tf-test-saas")
+	dstModelName := acctest.RandomWithPrefix("tf-test-saas-dst")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSAAS(srcModelName, dstModelName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("juju_saas.a", "model_uuid", "juju_model.b", "uuid"),
+					resource.TestCheckResourceAttrPair("juju_saas.a", "offer_url", "juju_offer.a", "url"),
+					resource.TestCheckResourceAttr("juju_saas.a", "alias", "source"),
+					resource.TestCheckResourceAttr("juju_saas.a", "name", "source"),
+					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b1", "application.*", map[string]string{"name": "source", "endpoint": "source"}),
+					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b2", "application.*", map[string]string{"name": "source", "endpoint": "source"}),
+				),
+			},
+			{
+				// Removing an integration keeps the SAAS used by the other one.
+				Config: testAccResourceSAAS(srcModelName, dstModelName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_saas.a", "name", "source"),
+					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b1", "application.*", map[string]string{"name": "source", "endpoint": "source"}),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["juju_saas.a"].Primary.ID, nil
+				},
+				ImportState:  true,
+				ResourceName: "juju_saas.a",
+			},
+		},
+	})
+}
+
+func testAccResourceSAAS(srcModelName, dstModelName string, withB2 bool) string {
+	b2 := ""
+	if withB2 {
+		b2 = `
+resource "juju_integration" "b2" {
+  model_uuid = juju_model.b.uuid
+
+  application {
+    name     = juju_application.b2.name
+    endpoint = "sink"
+  }
+
+  application {
+    name     = juju_saas.a.name
+    endpoint = "source"
+  }
+}
+`
+	}
+	return fmt.Sprintf(`
+resource "juju_model" "a" {
+  name = %q
+}
+
+resource "juju_application" "a" {
+  model_uuid = juju_model.a.uuid
+  name       = "a"
+
+  charm {
+    name = "juju-qa-dummy-sink"
+  }
+}
+
+resource "juju_offer" "a" {
+  model_uuid       = juju_model.a.uuid
+  application_name = juju_application.a.name
+  endpoints        = ["source"]
+}
+
+resource "juju_model" "b" {
+  name = %q
+}
+
+resource "juju_saas" "a" {
+  model_uuid = juju_model.b.uuid
+  offer_url  = juju_offer.a.url
+  alias      = "source"
+}
+
+resource "juju_application" "b1" {
+  model_uuid = juju_model.b.uuid
+  name       = "b1"
+
+  charm {
+    name = "juju-qa-dummy-source"
+  }
+}
+
+resource "juju_application" "b2" {
+  model_uuid = juju_model.b.uuid
+  name       = "b2"
+
+  charm {
+    name = "juju-qa-dummy-source"
+  }
+}
+
+resource "juju_integration" "b1" {
+  model_uuid = juju_model.b.uuid
+
+  application {
+    name     = juju_application.b1.name
+    endpoint = "sink"
+  }
+
+  application {
+    name     = juju_saas.a.name
+    endpoint = "source"
+  }
+}
+%s`, srcModelName, dstModelName, b2)
+}
+
+// TestAcc_ResourceSAASCrossController consumes an offer hosted on the
+// controller named by TEST_OFFERING_CONTROLLER in the local Juju client
+// store.
+func TestAcc_ResourceSAASCrossController(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	offeringController := os.Getenv(TestOfferingControllerEnvKey)
+	if offeringController == "" {
+		t.Skip(t.Name() + " requires " + TestOfferingControllerEnvKey + " to be set")
+	}
+	// The offering provider is given the details of the controller
+	// explicitly, as the environment variables set for the tests take
+	// precedence over the controller name.
+	controllerConfig, err := juju.GetLocalControllerConfig(offeringController)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if controllerConfig.Password == "" {
+		t.Skip(t.Name() + " requires a password for the account of " + offeringController)
+	}
+	srcModelName := acctest.RandomWithPrefix("tf-test-saas")
+	dstModelName := acctest.RandomWithPrefix("tf-test-saas-dst")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSAASCrossController(controllerConfig, srcModelName, dstModelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("juju_saas.a", "model_uuid", "juju_model.b", "uuid"),
+					resource.TestCheckResourceAttr("juju_saas.a", "name", "a"),
+					func(s *terraform.State) error {
+						url := s.RootModule().Resources["juju_offer.a"].Primary.Attributes["url"]
+						return resource.TestCheckResourceAttr("juju_saas.a", "offer_url", offeringController+":"+url)(s)
+					},
+				),
+			},
+			{
+				// The offer URL is imported with the name of the
+				// controller hosting the offer.
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["juju_saas.a"].Primary.ID, nil
+				},
+				ImportState:  true,
+				ResourceName: "juju_saas.a",
+			},
+		},
+	})
+}
+
+func testAccResourceSAASCrossController(offeringController *juju.LocalControllerConfig, srcModelName, dstModelName string) string {
+	return fmt.Sprintf(`
+provider "juju" {
+  alias                = "offering"
+  controller_addresses = %q
+  username             = %q
+  password             = %q
+  ca_certificate       = %q
+}
+
+resource "juju_model" "a" {
+  provider = juju.offering
+  name     = %q
+}
+
+resource "juju_application" "a" {
+  provider   = juju.offering
+  model_uuid = juju_model.a.uuid
+  name       = "a"
+
+  charm {
+    name = "juju-qa-dummy-sink"
+  }
+}
+
+resource "juju_offer" "a" {
+  provider         = juju.offering
+  model_uuid       = juju_model.a.uuid
+  application_name = juju_application.a.name
+  endpoints        = ["source"]
+}
+
+resource "juju_model" "b" {
+  name = %q
+}
+
+resource "juju_saas" "a" {
+  model_uuid = juju_model.b.uuid
+  offer_url  = "%s:${juju_offer.a.url}"
+}
+`, strings.Join(offeringController.ControllerAddresses, ","), offeringController.Username, offeringController.Password,
+		offeringController.CACert, srcModelName, dstModelName, offeringController.ControllerName)
+}
@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -63,6 +65,16 @@ type secretResourceModelV1 struct {
 	secretResourceModel
 	// ModelUUID to which the secret belongs. This attribute is required for all actions.
 	ModelUUID types.String `tfsdk:"model_uuid"`
+	// AutoPrune is whether unused revisions of the secret are pruned automatically.
+	AutoPrune types.Bool `tfsdk:"auto_prune"`
+	// RotatePolicy is how often the secret is rotated, as reported by Juju.
+	RotatePolicy types.String `tfsdk:"rotate_policy"`
+	// ExpireTime is when the latest revision of the secret expires, as reported by Juju.
+	ExpireTime types.String `tfsdk:"expire_time"`
+	// Revision is the latest revision of the secret.
+	Revision types.Int64 `tfsdk:"revision"`
+	// Revisions are the revisions of the secret which have not been pruned.
+	Revisions types.List `tfsdk:"revisions"`
 }
 
 // ImportState reads the secret based on the model name and secret name to be
@@ -115,6 +127,10 @@ func (s *secretResource) ImportState(ctx context.Context, req resource.ImportSta
 		return
 	}
 	state.Value = secretValue
+	resp.Diagnostics.Append(setSecretRevisionState(ctx, &state, readSecretOutput)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save state into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -170,6 +186,37 @@ func (s *secretResource) Schema(_ context.Context, req resource.SchemaRequest, r
 				Description: "The description of the secret.",
 				Optional:    true,
 			},
+			"auto_prune": schema.BoolAttribute{
+				Description: "Whether revisions of the secret which are no longer used by any consumer " +
+					"are removed automatically. Removing this attribute turns pruning off.",
+				Optional: true,
+			},
+			"rotate_policy": schema.StringAttribute{
+				Description: "How often the secret is rotated, as reported by Juju. Juju does not support " +
+					"setting a rotation policy on user secrets, so this is only set if the controller supports it.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expire_time": schema.StringAttribute{
+				Description: "When the latest revision of the secret expires, in RFC 3339 format, as reported " +
+					"by Juju. Juju does not support setting an expiry time on user secrets, so this is only set " +
+					"if the controller supports it.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revision": schema.Int64Attribute{
+				Description: "The latest revision of the secret. A new revision is created every time the value changes.",
+				Computed:    true,
+			},
+			"revisions": schema.ListAttribute{
+				Description: "The revisions of the secret which have not been removed, in ascending order.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
 			"id": schema.StringAttribute{
 				Description: "The ID of the secret. Used for terraform import.",
 				Computed:    true,
@@ -227,6 +274,7 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 		Name:      plan.Name.ValueString(),
 		Value:     secretValue,
 		Info:      plan.Info.ValueString(),
+		AutoPrune: plan.AutoPrune.ValueBoolPointer(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add secret, got error: %s", err))
@@ -236,6 +284,19 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.SecretId = types.StringValue(createSecretOutput.SecretId)
 	plan.SecretURI = types.StringValue(createSecretOutput.SecretURI)
 	plan.ID = types.StringValue(newSecretID(plan.ModelUUID.ValueString(), plan.SecretId.ValueString()))
+
+	readSecretOutput, err := s.client.Secrets.ReadSecret(&juju.ReadSecretInput{
+		SecretId:  createSecretOutput.SecretId,
+		ModelUUID: plan.ModelUUID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setSecretRevisionState(ctx, &plan, readSecretOutput)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	s.trace(fmt.Sprintf("saving secret resource %q", plan.SecretId.ValueString()),
 		map[string]interface{}{
 			"secretID": plan.SecretId.ValueString(),
@@ -285,6 +346,9 @@ func (s *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if !state.Info.IsNull() {
 		state.Info = types.StringValue(readSecretOutput.Info)
 	}
+	if !state.AutoPrune.IsNull() || readSecretOutput.AutoPrune {
+		state.AutoPrune = types.BoolValue(readSecretOutput.AutoPrune)
+	}
 	state.SecretURI = types.StringValue(readSecretOutput.SecretURI)
 	state.ID = types.StringValue(newSecretID(state.ModelUUID.ValueString(), readSecretOutput.SecretId))
 
@@ -294,6 +358,10 @@ func (s *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 	state.Value = secretValue
+	resp.Diagnostics.Append(setSecretRevisionState(ctx, &state, readSecretOutput)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save state into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -357,13 +425,34 @@ func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		updatedSecretInput.Info = plan.Info.ValueStringPointer()
 	}
 
-	if noChange {
-		return
+	// Check if auto prune has changed
+	if !plan.AutoPrune.Equal(state.AutoPrune) {
+		noChange = false
+		state.AutoPrune = plan.AutoPrune
+		// Removing auto_prune turns pruning off.
+		autoPrune := plan.AutoPrune.ValueBool()
+		updatedSecretInput.AutoPrune = &autoPrune
 	}
 
-	err = s.client.Secrets.UpdateSecret(&updatedSecretInput)
+	if !noChange {
+		err = s.client.Secrets.UpdateSecret(&updatedSecretInput)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret, got error: %s", err))
+			return
+		}
+	}
+
+	// Read the secret back, as changing the value creates a new revision.
+	readSecretOutput, err := s.client.Secrets.ReadSecret(&juju.ReadSecretInput{
+		SecretId:  state.SecretId.ValueString(),
+		ModelUUID: state.ModelUUID.ValueString(),
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setSecretRevisionState(ctx, &state, readSecretOutput)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -414,6 +503,27 @@ func newSecretID(modelUUID, secret string) string {
 	return fmt.Sprintf("%s:%s", modelUUID, secret)
 }
 
+// setSecretRevisionState sets the computed revision, rotation and
+// expiry attributes of the secret from the secret read from Juju.
+func setSecretRevisionState(ctx context.Context, state *secretResourceModelV1, output juju.ReadSecretOutput) diag.Diagnostics {
+	state.Revision = types.Int64Value(int64(output.Revision))
+	revisions, diags := types.ListValueFrom(ctx, types.Int64Type, output.Revisions)
+	if diags.HasError() {
+		return diags
+	}
+	state.Revisions = revisions
+
+	state.RotatePolicy = types.StringNull()
+	if output.RotatePolicy != "" {
+		state.RotatePolicy = types.StringValue(output.RotatePolicy)
+	}
+	state.ExpireTime = types.StringNull()
+	if output.ExpireTime != nil {
+		state.ExpireTime = types.StringValue(output.ExpireTime.Format(time.RFC3339))
+	}
+	return diags
+}
+
 func (o *secretResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		0: {
@@ -465,6 +575,7 @@ func (o *secretResource) UpgradeState(ctx context.Context) map[int64]resource.St
 				upgradedStateData := secretResourceModelV1{
 					ModelUUID:           types.StringValue(modelUUID),
 					secretResourceModel: priorStateData.secretResourceModel,
+					Revisions:           types.ListNull(types.Int64Type),
 				}
 
 				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
@@ -0,0 +1,397 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// secretBackendTokenKey is the config key of the token used to access
+// a secret backend. Juju replaces the token itself when the token
+// rotate interval is set, so it is not refreshed from the controller.
+const secretBackendTokenKey = "token"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &secretBackendResource{}
+var _ resource.ResourceWithConfigure = &secretBackendResource{}
+var _ resource.ResourceWithConfigValidators = &secretBackendResource{}
+var _ resource.ResourceWithImportState = &secretBackendResource{}
+
+// NewSecretBackendResource returns a new instance of the secret backend resource.
+func NewSecretBackendResource() resource.Resource {
+	return &secretBackendResource{}
+}
+
+type secretBackendResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type secretBackendResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	BackendType         types.String `tfsdk:"backend_type"`
+	Config              types.Map    `tfsdk:"config"`
+	TokenRotateInterval types.String `tfsdk:"token_rotate_interval"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Configure is used to configure the secret backend resource.
+func (r *secretBackendResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = provider.Client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceSecretBackend)
+}
+
+// ConfigValidators sets validators for the resource.
+func (r *secretBackendResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewAvoidJAASValidator(r.client, ""),
+	}
+}
+
+// Metadata returns the metadata for the secret backend resource.
+func (r *secretBackendResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_backend"
+}
+
+// Schema returns the schema for the secret backend resource.
+func (r *secretBackendResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a secret backend of the controller, e.g. a Vault server, " +
+			"which models can use to store the content of their secrets.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the secret backend.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"backend_type": schema.StringAttribute{
+				Description: "The type of the secret backend. Changing this value will cause the " +
+					"secret backend to be destroyed and recreated by terraform.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("kubernetes", "vault"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.MapAttribute{
+				Description: "The configuration of the secret backend, e.g. the endpoint and token of a " +
+					"Vault server. Changes made outside of terraform to the token are not detected, as " +
+					"Juju replaces it when the token rotate interval is set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token_rotate_interval": schema.StringAttribute{
+				Description: "How often the token used to access the secret backend is rotated, " +
+					"as a duration, e.g. \"48h\".",
+				Optional: true,
+				Validators: []validator.String{
+					ValidatorMatchString(func(s string) bool {
+						_, err := time.ParseDuration(s)
+						return err == nil
+					}, "must be a valid duration"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ImportState imports a secret backend by name.
+func (r *secretBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// Create adds a new secret backend to the controller.
+func (r *secretBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret_backend", "create")
+		return
+	}
+
+	var plan secretBackendResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config map[string]string
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &config, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tokenRotateInterval, err := parseTokenRotateInterval(plan.TokenRotateInterval)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("token_rotate_interval"), "Invalid Token Rotate Interval", err.Error())
+		return
+	}
+
+	output, err := r.client.SecretBackends.CreateSecretBackend(&juju.CreateSecretBackendInput{
+		Name:                plan.Name.ValueString(),
+		BackendType:         plan.BackendType.ValueString(),
+		TokenRotateInterval: tokenRotateInterval,
+		Config:              secretBackendConfigToAPI(config),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret backend, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("created secret backend %q", plan.Name.ValueString()))
+
+	plan.ID = types.StringValue(output.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the current state of the secret backend.
+func (r *secretBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret_backend", "read")
+		return
+	}
+
+	var state secretBackendResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := r.client.SecretBackends.ReadSecretBackend(&juju.ReadSecretBackendInput{
+		Name: state.Name.ValueString(),
+	})
+	if errors.Is(err, errors.NotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret backend, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read secret backend %q", output.Name))
+
+	state.ID = types.StringValue(output.ID)
+	state.Name = types.StringValue(output.Name)
+	state.BackendType = types.StringValue(output.BackendType)
+	state.TokenRotateInterval = tokenRotateIntervalFromAPI(output.TokenRotateInterval, state.TokenRotateInterval)
+
+	if !state.Config.IsNull() {
+		var stateConfig map[string]string
+		resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config, diags := types.MapValueFrom(ctx, types.StringType, secretBackendConfigFromAPI(output.Config, stateConfig))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Config = config
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the name, config and token rotate interval of the
+// secret backend.
+func (r *secretBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret_backend", "update")
+		return
+	}
+
+	var plan, state secretBackendResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := juju.UpdateSecretBackendInput{
+		Name: state.Name.ValueString(),
+	}
+	update := false
+
+	if !plan.Name.Equal(state.Name) {
+		update = true
+		input.NameChange = plan.Name.ValueStringPointer()
+	}
+
+	if !plan.TokenRotateInterval.Equal(state.TokenRotateInterval) {
+		update = true
+		tokenRotateInterval, err := parseTokenRotateInterval(plan.TokenRotateInterval)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("token_rotate_interval"), "Invalid Token Rotate Interval", err.Error())
+			return
+		}
+		if tokenRotateInterval == nil {
+			// A zero interval disables the token rotation.
+			tokenRotateInterval = new(time.Duration)
+		}
+		input.TokenRotateInterval = tokenRotateInterval
+	}
+
+	if !plan.Config.Equal(state.Config) {
+		update = true
+		config, reset, diags := computeConfigDiff(ctx, state.Config, plan.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input.Config = secretBackendConfigToAPI(config)
+		input.Reset = reset
+	}
+
+	if update {
+		err := r.client.SecretBackends.UpdateSecretBackend(&input)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret backend, got error: %s", err))
+			return
+		}
+		r.trace(fmt.Sprintf("updated secret backend %q", plan.Name.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the secret backend from the controller. It fails if
+// any model is still using the secret backend.
+func (r *secretBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret_backend", "delete")
+		return
+	}
+
+	var state secretBackendResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SecretBackends.DeleteSecretBackend(&juju.DeleteSecretBackendInput{
+		Name: state.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret backend, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("deleted secret backend %q", state.Name.ValueString()))
+}
+
+func (r *secretBackendResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+	tflog.SubsystemTrace(r.subCtx, LogResourceSecretBackend, msg, additionalFields...)
+}
+
+// parseTokenRotateInterval returns the duration of the token rotate
+// interval, or nil if it is not set.
+func parseTokenRotateInterval(value types.String) (*time.Duration, error) {
+	if value.ValueString() == "" {
+		return nil, nil
+	}
+	interval, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return &interval, nil
+}
+
+// tokenRotateIntervalFromAPI returns the token rotate interval read from
+// the controller, keeping the value in state if it is the same duration
+// written differently, e.g. "48h" instead of "48h0m0s".
+func tokenRotateIntervalFromAPI(interval *time.Duration, stateValue types.String) types.String {
+	stateInterval, err := parseTokenRotateInterval(stateValue)
+	if err != nil {
+		stateInterval = nil
+	}
+	if interval == nil || *interval == 0 {
+		if stateInterval != nil && *stateInterval == 0 {
+			return stateValue
+		}
+		return types.StringNull()
+	}
+	if stateInterval != nil && *stateInterval == *interval {
+		return stateValue
+	}
+	return types.StringValue(interval.String())
+}
+
+// secretBackendConfigToAPI converts the config of the secret backend to
+// the format expected by the Juju API.
+func secretBackendConfigToAPI(config map[string]string) map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		result[k] = v
+	}
+	return result
+}
+
+// secretBackendConfigFromAPI returns the config keys which are in the
+// state, with the values read from the controller. Values which are not
+// strings, and the token, are kept as they are in the state.
+func secretBackendConfigFromAPI(apiConfig map[string]interface{}, stateConfig map[string]string) map[string]string {
+	config := make(map[string]string, len(stateConfig))
+	for k, v := range stateConfig {
+		apiValue, ok := apiConfig[k]
+		if !ok {
+			continue
+		}
+		if s, ok := apiValue.(string); ok && k != secretBackendTokenKey {
+			config[k] = s
+		} else {
+			config[k] = v
+		}
+	}
+	return config
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenRotateIntervalFromAPI(t *testing.T) {
+	interval := 48 * time.Hour
+	zero := time.Duration(0)
+
+	assert.Equal(t, types.StringValue("48h"), tokenRotateIntervalFromAPI(&interval, types.StringValue("48h")))
+	assert.Equal(t, types.StringValue("48h0m0s"), tokenRotateIntervalFromAPI(&interval, types.StringValue("24h")))
+	assert.Equal(t, types.StringValue("48h0m0s"), tokenRotateIntervalFromAPI(&interval, types.StringNull()))
+	assert.Equal(t, types.StringNull(), tokenRotateIntervalFromAPI(nil, types.StringValue("48h")))
+	assert.Equal(t, types.StringNull(), tokenRotateIntervalFromAPI(&zero, types.StringNull()))
+	assert.Equal(t, types.StringValue("0s"), tokenRotateIntervalFromAPI(&zero, types.StringValue("0s")))
+}
+
+func TestSecretBackendConfigFromAPI(t *testing.T) {
+	apiConfig := map[string]interface{}{
+		"endpoint":  "http://vault.example.com:8200",
+		"token":     "s.rotated",
+		"namespace": "new-namespace",
+		"ca-certs":  []interface{}{"cert"},
+		"extra":     "not in state",
+	}
+	stateConfig := map[string]string{
+		"endpoint":  "http://vault.example.com:8200",
+		"token":     "s.token",
+		"namespace": "namespace",
+		"ca-certs":  "cert",
+		"removed":   "value",
+	}
+	assert.Equal(t, map[string]string{
+		"endpoint":  "http://vault.example.com:8200",
+		"token":     "s.token",
+		"namespace": "new-namespace",
+		"ca-certs":  "cert",
+	}, secretBackendConfigFromAPI(apiConfig, stateConfig))
+}
+
+func TestAcc_ResourceSecretBackend(t *testing.T) {
+	vaultAddr, vaultToken := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+	if vaultAddr == "" || vaultToken == "" {
+		t.Skip(t.Name() + " requires VAULT_ADDR and VAULT_TOKEN to be set")
+	}
+	backendName := acctest.RandomWithPrefix("tf-test-vault")
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecretBackend(backendName, modelName, vaultAddr, vaultToken, "48h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_secret_backend.vault", "name", backendName),
+					resource.TestCheckResourceAttr("juju_secret_backend.vault", "backend_type", "vault"),
+					resource.TestCheckResourceAttr("juju_secret_backend.vault", "token_rotate_interval", "48h"),
+					resource.TestCheckResourceAttrSet("juju_secret_backend.vault", "id"),
+					resource.TestCheckResourceAttr("juju_model.this", "secret_backend", backendName),
+				),
+			},
+			{
+				Config: testAccResourceSecretBackend(backendName, modelName, vaultAddr, vaultToken, "72h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_secret_backend.vault", "token_rotate_interval", "72h"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config"},
+				ImportStateId:           backendName,
+				ImportState:             true,
+				ResourceName:            "juju_secret_backend.vault",
+			},
+		},
+	})
+}
+
+func testAccResourceSecretBackend(backendName, modelName, vaultAddr, vaultToken, tokenRotateInterval string) string {
+	return fmt.Sprintf(`
+resource "juju_secret_backend" "vault" {
+  name                  = %q
+  backend_type          = "vault"
+  token_rotate_interval = %q
+
+  config = {
+    endpoint = %q
+    token    = %q
+  }
+}
+
+resource "juju_model" "this" {
+  name           = %q
+  secret_backend = juju_secret_backend.vault.name
+}
+`, backendName, tokenRotateInterval, vaultAddr, vaultToken, modelName)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
+	"github.com/juju/terraform-provider-juju/internal/juju"
 	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
 )
 
@@ -170,6 +171,7 @@ func TestAcc_ResourceSecret_Update(t *testing.T) {
 					resource.TestCheckResourceAttr("juju_secret."+secretName, "info", secretInfo),
 					resource.TestCheckResourceAttr("juju_secret."+secretName, "value.key1", "value1"),
 					resource.TestCheckResourceAttr("juju_secret."+secretName, "value.key2", "value2"),
+					resource.TestCheckResourceAttr("juju_secret."+secretName, "revision", "1"),
 				),
 			},
 			{
@@ -181,12 +183,85 @@ func TestAcc_ResourceSecret_Update(t *testing.T) {
 					resource.TestCheckResourceAttr("juju_secret."+secretName, "value.key1", "value1"),
 					resource.TestCheckResourceAttr("juju_secret."+secretName, "value.key2", "newValue2"),
 					resource.TestCheckResourceAttr("juju_secret."+secretName, "value.key3", "value3"),
+					resource.TestCheckResourceAttr("juju_secret."+secretName, "revision", "2"),
+					resource.TestCheckResourceAttr("juju_secret."+secretName, "revisions.#", "2"),
 				),
 			},
 		},
 	})
 }
 
+func TestAcc_ResourceSecret_AutoPrune(t *testing.T) {
+	agentVersion := os.Getenv(TestJujuAgentVersion)
+	if agentVersion == "" {
+		t.Errorf("%s is not set", TestJujuAgentVersion)
+	} else if internaltesting.CompareVersions(agentVersion, "3.3.0") < 0 {
+		t.Skipf("%s is not set or is below 3.3.0", TestJujuAgentVersion)
+	}
+
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	secretName := "tf-test-secret"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecretWithAutoPrune(modelName, secretName, "value1", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_secret."+secretName, "auto_prune", "true"),
+					resource.TestCheckResourceAttr("juju_secret."+secretName, "revision", "1"),
+					resource.TestCheckResourceAttr("juju_secret."+secretName, "revisions.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceSecretWithAutoPrune(modelName, secretName, "value2", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_secret."+secretName, "auto_prune", "false"),
+					resource.TestCheckResourceAttr("juju_secret."+secretName, "revision", "2"),
+				),
+			},
+			{
+				Config: testAccResourceSecretWithAutoPrune(modelName, secretName, "value2", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_secret."+secretName, "auto_prune", "true"),
+					testAccCheckSecretAutoPrune("juju_secret."+secretName, true),
+				),
+			},
+			{
+				// Removing auto_prune turns pruning off.
+				Config: testAccResourceSecretWithAutoPrune(modelName, secretName, "value2", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("juju_secret."+secretName, "auto_prune"),
+					testAccCheckSecretAutoPrune("juju_secret."+secretName, false),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckSecretAutoPrune checks whether Juju prunes the revisions of
+// the secret automatically.
+func testAccCheckSecretAutoPrune(resourceName string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		output, err := TestClient.Secrets.ReadSecret(&juju.ReadSecretInput{
+			SecretId:  rs.Primary.Attributes["secret_id"],
+			ModelUUID: rs.Primary.Attributes["model_uuid"],
+		})
+		if err != nil {
+			return err
+		}
+		if output.AutoPrune != expected {
+			return fmt.Errorf("expected auto prune of secret %q to be %t, got %t", rs.Primary.Attributes["secret_id"], expected, output.AutoPrune)
+		}
+		return nil
+	}
+}
+
 func TestAcc_ResourceSecret_UpgradeV0ToV1(t *testing.T) {
 	agentVersion := os.Getenv(TestJujuAgentVersion)
 	if agentVersion == "" {
@@ -259,6 +334,28 @@ resource "juju_secret" "{{.SecretName}}" {
 		})
 }
 
+// testAccResourceSecretWithAutoPrune returns the configuration of a secret
+// with auto_prune set to autoPrune, or not set if autoPrune is empty.
+func testAccResourceSecretWithAutoPrune(modelName, secretName, secretValue, autoPrune string) string {
+	if autoPrune != "" {
+		autoPrune = "auto_prune = " + autoPrune
+	}
+	return fmt.Sprintf(`
+resource "juju_model" %[1]q {
+  name = %[1]q
+}
+
+resource "juju_secret" %[2]q {
+  model_uuid = juju_model.%[1]s.uuid
+  name       = %[2]q
+  value = {
+    key = %[3]q
+  }
+  %[4]s
+}
+`, modelName, secretName, secretValue, autoPrune)
+}
+
 func testAccResourceSecretV0(modelName, secretName string, secretValue map[string]string, secretInfo string) string {
 	return internaltesting.GetStringFromTemplateWithData(
 		"testAccResourceSecret",
@@ -0,0 +1,359 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &spaceResource{}
+var _ resource.ResourceWithConfigure = &spaceResource{}
+var _ resource.ResourceWithImportState = &spaceResource{}
+var _ resource.ResourceWithModifyPlan = &spaceResource{}
+
+// NewSpaceResource returns a new instance of the space resource.
+func NewSpaceResource() resource.Resource {
+	return &spaceResource{}
+}
+
+type spaceResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type spaceResourceModel struct {
+	ModelUUID types.String `tfsdk:"model_uuid"`
+	Name      types.String `tfsdk:"name"`
+	Subnets   types.Set    `tfsdk:"subnets"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Configure is used to configure the space resource.
+func (r *spaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(juju.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected juju.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = provider.Client
+	r.subCtx = tflog.NewSubsystem(ctx, LogResourceSpace)
+}
+
+// Metadata returns the metadata for the space resource.
+func (r *spaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space"
+}
+
+// Schema returns the schema for the space resource.
+func (r *spaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a network space of a model. Spaces group subnets, and can be " +
+			"used in the endpoint bindings and constraints of applications and machines.",
+		Attributes: map[string]schema.Attribute{
+			"model_uuid": schema.StringAttribute{
+				Description: "The UUID of the model containing the space. Changing this value will cause the " +
+					"space to be destroyed and recreated by terraform.",
+				Required: true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidModel, "must be a valid UUID"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the space. Changing this value renames the space.",
+				Required:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidSpace, "must be a valid space name"),
+				},
+			},
+			"subnets": schema.SetAttribute{
+				Description: "The CIDRs of the subnets in the space. The subnets must already be known by the " +
+					"model, see the `juju_subnets` data source. Subnets removed from the space are moved back " +
+					"to the default space, `alpha`. If not set, the subnets of the space are not managed by terraform.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(ValidatorMatchString(func(s string) bool {
+						_, _, err := net.ParseCIDR(s)
+						return err == nil
+					}, "must be a valid CIDR")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ModifyPlan sets the ID of the space in the plan, as it changes when
+// the space is renamed.
+func (r *spaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the space is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan spaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ModelUUID.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"),
+		types.StringValue(newSpaceID(plan.ModelUUID.ValueString(), plan.Name.ValueString())))...)
+}
+
+// ImportState imports a space with an ID of the form <model_uuid>:<name>.
+func (r *spaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	modelUUID, name, err := spaceIDParts(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model_uuid"), modelUUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+
+	// Read only refreshes the subnets already managed by terraform, so
+	// the subnets of an imported space are read here.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "import")
+		return
+	}
+	output, err := r.client.Spaces.ReadSpace(&juju.ReadSpaceInput{
+		ModelUUID: modelUUID,
+		Name:      name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read space, got error: %s", err))
+		return
+	}
+	if len(output.CIDRs) > 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subnets"), output.CIDRs)...)
+	}
+}
+
+// Create creates the space and moves its subnets into it.
+func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "create")
+		return
+	}
+
+	var plan spaceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cidrs []string
+	resp.Diagnostics.Append(plan.Subnets.ElementsAs(ctx, &cidrs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Spaces.CreateSpace(&juju.CreateSpaceInput{
+		ModelUUID: plan.ModelUUID.ValueString(),
+		Name:      plan.Name.ValueString(),
+		CIDRs:     cidrs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create space, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("created space %q", plan.Name.ValueString()))
+
+	plan.ID = types.StringValue(newSpaceID(plan.ModelUUID.ValueString(), plan.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the current state of the space.
+func (r *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "read")
+		return
+	}
+
+	var state spaceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := r.client.Spaces.ReadSpace(&juju.ReadSpaceInput{
+		ModelUUID: state.ModelUUID.ValueString(),
+		Name:      state.Name.ValueString(),
+	})
+	if errors.Is(err, errors.NotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read space, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read space %q", output.Name))
+
+	state.Name = types.StringValue(output.Name)
+	// Keep subnets null if they are not managed by terraform, else
+	// the next plan would move the subnets of the space out of it.
+	if !state.Subnets.IsNull() {
+		subnets, diags := types.SetValueFrom(ctx, types.StringType, output.CIDRs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Subnets = subnets
+	}
+	state.ID = types.StringValue(newSpaceID(state.ModelUUID.ValueString(), output.Name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update renames the space, then moves subnets in and out of it.
+func (r *spaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "update")
+		return
+	}
+
+	var plan, state spaceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planCIDRs, stateCIDRs []string
+	resp.Diagnostics.Append(plan.Subnets.ElementsAs(ctx, &planCIDRs, false)...)
+	resp.Diagnostics.Append(state.Subnets.ElementsAs(ctx, &stateCIDRs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		err := r.client.Spaces.RenameSpace(&juju.RenameSpaceInput{
+			ModelUUID: state.ModelUUID.ValueString(),
+			Name:      state.Name.ValueString(),
+			NewName:   plan.Name.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename space, got error: %s", err))
+			return
+		}
+		r.trace(fmt.Sprintf("renamed space %q to %q", state.Name.ValueString(), plan.Name.ValueString()))
+
+		// Save the new name before moving the subnets, else the space
+		// is not found by its old name if moving the subnets fails.
+		state.Name = plan.Name
+		state.ID = types.StringValue(newSpaceID(state.ModelUUID.ValueString(), state.Name.ValueString()))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.client.Spaces.UpdateSpace(&juju.UpdateSpaceInput{
+		ModelUUID:   state.ModelUUID.ValueString(),
+		Name:        state.Name.ValueString(),
+		AddCIDRs:    missingFrom(planCIDRs, stateCIDRs),
+		RemoveCIDRs: missingFrom(stateCIDRs, planCIDRs),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update space, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("updated space %q", plan.Name.ValueString()))
+
+	plan.ID = types.StringValue(newSpaceID(plan.ModelUUID.ValueString(), plan.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the space. Its subnets are moved back to the default
+// space. It fails if the space is still used by applications or
+// machines.
+func (r *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "delete")
+		return
+	}
+
+	var state spaceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Spaces.DeleteSpace(&juju.DeleteSpaceInput{
+		ModelUUID: state.ModelUUID.ValueString(),
+		Name:      state.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete space, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("deleted space %q", state.Name.ValueString()))
+}
+
+func (r *spaceResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+	tflog.SubsystemTrace(r.subCtx, LogResourceSpace, msg, additionalFields...)
+}
+
+func newSpaceID(modelUUID, name string) string {
+	return fmt.Sprintf("%s:%s", modelUUID, name)
+}
+
+// spaceIDParts returns the model UUID and the name of the space from
+// an ID of the form <model_uuid>:<name>.
+func spaceIDParts(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || !names.IsValidModel(parts[0]) || !names.IsValidSpace(parts[1]) {
+		return "", "", fmt.Errorf("expected identifier with format <model_uuid>:<space_name>, got %q", id)
+	}
+	return parts[0], parts[1], nil
+}
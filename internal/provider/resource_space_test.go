@@ -0,0 +1,126 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func TestSpaceIDParts(t *testing.T) {
+	modelUUID, name, err := spaceIDParts("6b0b4bd5-5f64-4c4f-9fa5-6e0a5b9f1d4c:db")
+	assert.NoError(t, err)
+	assert.Equal(t, "6b0b4bd5-5f64-4c4f-9fa5-6e0a5b9f1d4c", modelUUID)
+	assert.Equal(t, "db", name)
+
+	for _, id := range []string{
+		"",
+		"db",
+		"not-a-uuid:db",
+		"6b0b4bd5-5f64-4c4f-9fa5-6e0a5b9f1d4c:",
+		"6b0b4bd5-5f64-4c4f-9fa5-6e0a5b9f1d4c:db:extra",
+	} {
+		_, _, err := spaceIDParts(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestAcc_ResourceSpace(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-space")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSpace(modelName, "db"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("juju_space.this", "model_uuid", "juju_model.this", "uuid"),
+					resource.TestCheckResourceAttr("juju_space.this", "name", "db"),
+					resource.TestCheckNoResourceAttr("juju_space.this", "subnets"),
+				),
+			},
+			{
+				Config: testAccResourceSpace(modelName, "database"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_space.this", "name", "database"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["juju_space.this"].Primary.ID, nil
+				},
+				ImportState:  true,
+				ResourceName: "juju_space.this",
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceSpaceUnmanagedSubnets(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-space")
+	var modelUUID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSpace(modelName, "db"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("juju_space.this", "subnets"),
+					func(s *terraform.State) error {
+						modelUUID = s.RootModule().Resources["juju_space.this"].Primary.Attributes["model_uuid"]
+						return nil
+					},
+				),
+			},
+			{
+				// Move a subnet into the space outside of terraform, the
+				// subnets are not managed by terraform so the plan is empty.
+				PreConfig: func() {
+					output, err := TestClient.Spaces.ListSubnets(&juju.ListSubnetsInput{ModelUUID: modelUUID})
+					require.NoError(t, err)
+					require.NotEmpty(t, output.Subnets)
+					err = TestClient.Spaces.UpdateSpace(&juju.UpdateSpaceInput{
+						ModelUUID: modelUUID,
+						Name:      "db",
+						AddCIDRs:  []string{output.Subnets[0].CIDR},
+					})
+					require.NoError(t, err)
+				},
+				Config:   testAccResourceSpace(modelName, "db"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSpace(modelName, spaceName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_space" "this" {
+  model_uuid = juju_model.this.uuid
+  name       = %q
+}
+`, modelName, spaceName)
+}
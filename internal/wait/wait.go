@@ -86,6 +86,13 @@ type WaitForCfg[I any, D any] struct {
 	// NonFatalErrors is a list of non-fatal errors to ignore.
 	NonFatalErrors []error
 
+	// Changes, if set, returns a channel which is closed when the data
+	// may have changed, e.g. juju.Client.ModelChanged. The data is then
+	// fetched again after the initial delay rather than the backed off
+	// one. A nil channel means changes cannot be watched, in which case
+	// the data is polled as usual.
+	Changes func() <-chan struct{}
+
 	// RetryConf is a configuration for retrying the operation.
 	// If not provided, default values will be used.
 	RetryConf *RetryConf
@@ -110,6 +117,13 @@ type WaitForErrorCfg[I any, D any] struct {
 	// RetryAllErrors indicates whether to retry on all errors.
 	RetryAllErrors bool
 
+	// Changes, if set, returns a channel which is closed when the data
+	// may have changed, e.g. juju.Client.ModelChanged. The data is then
+	// fetched again after the initial delay rather than the backed off
+	// one. A nil channel means changes cannot be watched, in which case
+	// the data is polled as usual.
+	Changes func() <-chan struct{}
+
 	// RetryConf is a configuration for retrying the operation.
 	// If not provided, default values will be used.
 	RetryConf *RetryConf
@@ -130,9 +144,11 @@ type Assert[D any] func(D) error
 // and a list of non-fatal errors to ignore.
 func WaitFor[I any, D any](waitCfg WaitForCfg[I, D]) (D, error) {
 	waitCfg.setRetryConfDefaults()
+	clk := newChangesClock(waitCfg.RetryConf, waitCfg.Changes)
 	var data D
 	retryErr := retry.Call(retry.CallArgs{
 		Func: func() error {
+			clk.watch()
 			var err error
 			data, err = waitCfg.GetData(waitCfg.Input)
 			if err != nil {
@@ -158,7 +174,7 @@ func WaitFor[I any, D any](waitCfg WaitForCfg[I, D]) (D, error) {
 		MaxDuration: waitCfg.RetryConf.MaxDuration,
 		Delay:       waitCfg.RetryConf.Delay,
 		MaxDelay:    waitCfg.RetryConf.MaxDelay,
-		Clock:       waitCfg.RetryConf.Clock,
+		Clock:       clk,
 		Stop:        waitCfg.Context.Done(),
 	})
 	return data, retryErr
@@ -167,9 +183,11 @@ func WaitFor[I any, D any](waitCfg WaitForCfg[I, D]) (D, error) {
 // WaitForError waits for a specific error to be returned from the getData function.
 func WaitForError[I any, D any](cfg WaitForErrorCfg[I, D]) error {
 	cfg.setRetryConfDefaults()
+	clk := newChangesClock(cfg.RetryConf, cfg.Changes)
 
 	retryErr := retry.Call(retry.CallArgs{
 		Func: func() error {
+			clk.watch()
 			_, err := cfg.GetData(cfg.Input)
 			if err == nil {
 				return juju.NewRetryReadError("no error returned")
@@ -194,8 +212,63 @@ func WaitForError[I any, D any](cfg WaitForErrorCfg[I, D]) error {
 		MaxDuration: cfg.RetryConf.MaxDuration,
 		Delay:       cfg.RetryConf.Delay,
 		MaxDelay:    cfg.RetryConf.MaxDelay,
-		Clock:       cfg.RetryConf.Clock,
+		Clock:       clk,
 		Stop:        cfg.Context.Done(),
 	})
 	return retryErr
 }
+
+// changesClock is a clock whose After returns early once the watched
+// data changed, so that retries follow the changes of the data instead
+// of only backing off.
+type changesClock struct {
+	clock.Clock
+
+	// minDelay is the delay between a change and the next retry, which
+	// avoids fetching the data again for every single change.
+	minDelay   time.Duration
+	getChanges func() <-chan struct{}
+	changes    <-chan struct{}
+}
+
+func newChangesClock(rc *RetryConf, getChanges func() <-chan struct{}) *changesClock {
+	return &changesClock{
+		Clock:      rc.Clock,
+		minDelay:   rc.Delay,
+		getChanges: getChanges,
+	}
+}
+
+// watch starts watching for the next change. It must be called before
+// fetching the data, so that no change is missed while fetching.
+func (c *changesClock) watch() {
+	if c.getChanges != nil {
+		c.changes = c.getChanges()
+	}
+}
+
+// After implements clock.Clock.
+func (c *changesClock) After(d time.Duration) <-chan time.Time {
+	if c.changes == nil || d <= c.minDelay {
+		return c.Clock.After(d)
+	}
+	changes := c.changes
+	timeout := c.Clock.After(d)
+	minimum := c.Clock.After(c.minDelay)
+	ch := make(chan time.Time, 1)
+	go func() {
+		select {
+		case t := <-timeout:
+			ch <- t
+			return
+		case <-changes:
+		}
+		select {
+		case t := <-timeout:
+			ch <- t
+		case t := <-minimum:
+			ch <- t
+		}
+	}()
+	return ch
+}
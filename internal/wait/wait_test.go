@@ -221,6 +221,74 @@ func TestWaitForError_MaxDuration(t *testing.T) {
 	}
 }
 
+func TestWaitForChanges(t *testing.T) {
+	counter := atomic.Int32{}
+	testFunc := func(string) (string, error) {
+		if counter.Add(1) < 10 {
+			return "", juju.RetryReadError
+		}
+		return "success", nil
+	}
+	changed := make(chan struct{})
+	close(changed)
+
+	start := time.Now()
+	result, err := wait.WaitFor(wait.WaitForCfg[string, string]{
+		Context:        t.Context(),
+		GetData:        testFunc,
+		Input:          "test",
+		NonFatalErrors: []error{juju.RetryReadError},
+		// Every retry sees a change, so the delay never backs off.
+		Changes: func() <-chan struct{} { return changed },
+		RetryConf: &wait.RetryConf{
+			MaxDuration: time.Hour,
+			Delay:       10 * time.Millisecond,
+			MaxDelay:    time.Hour,
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result != "success" {
+		t.Fatalf("expected success, got %v", result)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected retries to follow changes, took %v", elapsed)
+	}
+}
+
+func TestWaitForErrorNoChanges(t *testing.T) {
+	autoAdvancingClock := createAutoAdvancingClock(time.Now())
+	counter := atomic.Int32{}
+	testFunc := func(string) (string, error) {
+		if counter.Add(1) < 3 {
+			return "", juju.RetryReadError
+		}
+		return "", juju.ApplicationNotFoundError
+	}
+	err := wait.WaitForError(wait.WaitForErrorCfg[string, string]{
+		Context:        t.Context(),
+		GetData:        testFunc,
+		Input:          "test",
+		ExpectedErr:    juju.ApplicationNotFoundError,
+		NonFatalErrors: []error{juju.RetryReadError},
+		// A nil channel means the data is polled.
+		Changes: func() <-chan struct{} { return nil },
+		RetryConf: &wait.RetryConf{
+			MaxDuration: 60 * time.Second,
+			Delay:       1 * time.Second,
+			Clock:       autoAdvancingClock,
+			MaxDelay:    time.Minute,
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if counter.Load() != 3 {
+		t.Fatalf("expected 3 calls, got %d", counter.Load())
+	}
+}
+
 func createAutoAdvancingClock(now time.Time) *testclock.AutoAdvancingClock {
 	testClock := testclock.NewClock(now)
 	return &testclock.AutoAdvancingClock{
@@ -0,0 +1,220 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/provider"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout))
+}
+
+// run exports the model given in args, writes its output to stdout and
+// returns the exit code.
+func run(args []string, stdout io.Writer) int {
+	var controllerName, outDir, recordFile, replayFile string
+	flags := flag.NewFlagSet("juju-tf-exporter", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	flags.StringVar(&controllerName, "controller", "", "name of the controller in the local Juju client store, defaults to the current controller")
+	flags.StringVar(&outDir, "out", ".", "directory to write the Terraform files to")
+	flags.StringVar(&recordFile, "record", "", "also write the data read from the controller to this JSON file")
+	flags.StringVar(&replayFile, "replay", "", "read the model from a file written with -record instead of connecting to a controller")
+	flags.Usage = func() {
+		fmt.Fprintln(stdout, "Usage: juju-tf-exporter [flags] <model-uuid>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	if replayFile == "" && flags.NArg() != 1 {
+		flags.Usage()
+		return 1
+	}
+
+	var data *modelData
+	var err error
+	if replayFile != "" {
+		data, err = readRecording(replayFile)
+	} else {
+		data, err = readModelFromController(stdout, controllerName, flags.Arg(0))
+	}
+	if err == nil {
+		err = export(stdout, data, outDir, recordFile)
+	}
+	if err != nil {
+		fmt.Fprintf(stdout, "%v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// export writes the Terraform files of the model to outDir and, if
+// recordFile is set, the data read from the controller to recordFile.
+func export(stdout io.Writer, data *modelData, outDir, recordFile string) error {
+	if recordFile != "" {
+		if err := writeRecording(recordFile, data); err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "Recorded model %q to %s\n", data.Name, recordFile)
+	}
+
+	result, err := generateTerraform(data)
+	if err != nil {
+		return err
+	}
+	if err := writeFiles(stdout, outDir, result.Files); err != nil {
+		return err
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(stdout, "⚠️  %s\n", warning)
+	}
+	if _, ok := result.Files[variablesFile]; ok {
+		fmt.Fprintf(stdout, "Sensitive config values were replaced by variables, set them in %s before running terraform plan.\n", variablesFile)
+	}
+	return nil
+}
+
+// readModelFromController reads a model, its applications, integrations
+// and offers from the controller.
+func readModelFromController(stdout io.Writer, controllerName, modelUUID string) (*modelData, error) {
+	config, err := controllerConfiguration(controllerName)
+	if err != nil {
+		return nil, err
+	}
+	client, err := juju.NewClient(context.Background(), config, false)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to the controller: %w", err)
+	}
+
+	model, err := client.Models.ReadModel(modelUUID)
+	if err != nil {
+		return nil, fmt.Errorf("reading model %q: %w", modelUUID, err)
+	}
+	owner, err := names.ParseUserTag(model.ModelInfo.OwnerTag)
+	if err != nil {
+		return nil, fmt.Errorf("reading owner of model %q: %w", modelUUID, err)
+	}
+	cloud, err := names.ParseCloudTag(model.ModelInfo.CloudTag)
+	if err != nil {
+		return nil, fmt.Errorf("reading cloud of model %q: %w", modelUUID, err)
+	}
+	status, err := client.Models.ReadModelFullStatus(modelUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &modelData{
+		UUID:         modelUUID,
+		Name:         model.ModelInfo.Name,
+		Owner:        owner.Id(),
+		Cloud:        cloud.Id(),
+		CloudRegion:  model.ModelInfo.CloudRegion,
+		Constraints:  model.ModelConstraints.String(),
+		Status:       status,
+		Applications: make(map[string]*juju.ReadApplicationResponse, len(status.Applications)),
+	}
+	for _, appName := range slices.Sorted(maps.Keys(status.Applications)) {
+		fmt.Fprintf(stdout, "Reading application %q\n", appName)
+		app, err := client.Applications.ReadApplication(&juju.ReadApplicationInput{
+			ModelUUID: modelUUID,
+			AppName:   appName,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading application %q: %w", appName, err)
+		}
+		data.Applications[appName] = app
+	}
+	return data, nil
+}
+
+// controllerConfiguration returns the configuration to connect to the
+// controller. As for the provider, the JUJU_* environment variables take
+// precedence over the local Juju client store.
+func controllerConfiguration(controllerName string) (juju.ControllerConfiguration, error) {
+	if addrs := os.Getenv(provider.JujuControllerEnvKey); addrs != "" {
+		return juju.ControllerConfiguration{
+			ControllerAddresses: strings.Split(addrs, ","),
+			Username:            os.Getenv(provider.JujuUsernameEnvKey),
+			Password:            os.Getenv(provider.JujuPasswordEnvKey),
+			CACert:              os.Getenv(provider.JujuCACertEnvKey),
+			ClientID:            os.Getenv(provider.JujuClientIDEnvKey),
+			ClientSecret:        os.Getenv(provider.JujuClientSecretEnvKey),
+		}, nil
+	}
+	if controllerName == "" {
+		controllerName = os.Getenv(provider.JujuControllerNameEnvKey)
+	}
+	local, err := juju.GetLocalControllerConfig(controllerName)
+	if err != nil {
+		return juju.ControllerConfiguration{}, fmt.Errorf("reading the local Juju client store: %w", err)
+	}
+	return juju.ControllerConfiguration{
+		ControllerAddresses: local.ControllerAddresses,
+		Username:            local.Username,
+		Password:            local.Password,
+		CACert:              local.CACert,
+		ControllerName:      local.ControllerName,
+	}, nil
+}
+
+func readRecording(filename string) (*modelData, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading recording: %v", err)
+	}
+	var data modelData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("error parsing recording %s: %v", filename, err)
+	}
+	return &data, nil
+}
+
+func writeRecording(filename string, data *modelData) error {
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding recording: %v", err)
+	}
+	if err := os.WriteFile(filename, append(content, '\n'), 0600); err != nil {
+		return fmt.Errorf("error writing recording: %v", err)
+	}
+	return nil
+}
+
+// writeFiles writes the generated files to outDir and reports them to
+// stdout. Existing files are never overwritten.
+func writeFiles(stdout io.Writer, outDir string, files map[string][]byte) error {
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return fmt.Errorf("error creating output directory: %v", err)
+	}
+	fileNames := slices.Sorted(maps.Keys(files))
+	for _, name := range fileNames {
+		path := filepath.Join(outDir, name)
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("refusing to overwrite existing file %s", path)
+		}
+	}
+	for _, name := range fileNames {
+		path := filepath.Join(outDir, name)
+		if err := os.WriteFile(path, files[name], 0644); err != nil {
+			return fmt.Errorf("error writing %s: %v", path, err)
+		}
+		fmt.Fprintf(stdout, "  ✓ Wrote %s\n", path)
+	}
+	return nil
+}
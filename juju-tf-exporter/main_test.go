@@ -0,0 +1,140 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTerraform(t *testing.T) {
+	data, err := readRecording(filepath.Join("testdata", "model.json"))
+	require.NoError(t, err, "Error reading recorded model")
+
+	result, err := generateTerraform(data)
+	require.NoError(t, err, "Error generating Terraform files")
+
+	expectedFiles, err := filepath.Glob(filepath.Join("testdata", "out", "*.tf"))
+	require.NoError(t, err, "Error reading expected output directory")
+	expectedNames := make([]string, 0, len(expectedFiles))
+	for _, expectedFile := range expectedFiles {
+		expectedNames = append(expectedNames, filepath.Base(expectedFile))
+	}
+	require.ElementsMatch(t, expectedNames, slices.Collect(maps.Keys(result.Files)), "Unexpected generated files")
+
+	for _, expectedFile := range expectedFiles {
+		filename := filepath.Base(expectedFile)
+		t.Run(filename, func(t *testing.T) {
+			expectedContent, err := os.ReadFile(expectedFile)
+			require.NoError(t, err, "Error reading expected output file")
+
+			content, ok := result.Files[filename]
+			require.True(t, ok, "File %s was not generated", filename)
+			if string(content) != string(expectedContent) {
+				// Save actual output for debugging
+				actualFile := "actual_" + filename
+				_ = os.WriteFile(actualFile, content, 0644)
+
+				assert.Equal(t, string(expectedContent), string(content),
+					"Generated file does not match expected output. Actual output saved to %s", actualFile)
+			}
+		})
+	}
+
+	assert.Equal(t, []string{
+		`endpoint "database" of offer "mysql" is offered as "db", endpoint aliases are not supported`,
+	}, result.Warnings)
+}
+
+func TestGenerateTerraformMissingApplication(t *testing.T) {
+	data, err := readRecording(filepath.Join("testdata", "model.json"))
+	require.NoError(t, err, "Error reading recorded model")
+	delete(data.Applications, "mysql")
+
+	_, err = generateTerraform(data)
+	assert.ErrorContains(t, err, `missing details of application "mysql"`)
+}
+
+func TestGenerateTerraformKeepsStatus(t *testing.T) {
+	data, err := readRecording(filepath.Join("testdata", "model.json"))
+	require.NoError(t, err, "Error reading recorded model")
+	expected, err := readRecording(filepath.Join("testdata", "model.json"))
+	require.NoError(t, err, "Error reading recorded model")
+
+	_, err = generateTerraform(data)
+	require.NoError(t, err, "Error generating Terraform files")
+	assert.Equal(t, expected.Status, data.Status, "The status of the model was modified")
+}
+
+func TestTerraformName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"wordpress", "wordpress"},
+		{"mysql-k8s", "mysql_k8s"},
+		{"smtp.api_key", "smtp_api_key"},
+		{"Admin", "admin"},
+		{"7zip", "_7zip"},
+		{"", "_"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, terraformName(test.name), "input: %q", test.name)
+	}
+}
+
+func TestWriteFilesDoesNotOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, mainFile), []byte("# existing\n"), 0644))
+
+	err := writeFiles(io.Discard, dir, map[string][]byte{
+		importsFile: []byte("# imports\n"),
+		mainFile:    []byte("# main\n"),
+	})
+	assert.ErrorContains(t, err, "refusing to overwrite")
+
+	content, err := os.ReadFile(filepath.Join(dir, mainFile))
+	require.NoError(t, err)
+	assert.Equal(t, "# existing\n", string(content))
+	assert.NoFileExists(t, filepath.Join(dir, importsFile))
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	var stdout bytes.Buffer
+	code := run([]string{"-replay", filepath.Join("testdata", "model.json"), "-out", dir}, &stdout)
+	assert.Equal(t, 0, code, stdout.String())
+	assert.Contains(t, stdout.String(), "Wrote "+filepath.Join(dir, mainFile))
+	assert.FileExists(t, filepath.Join(dir, mainFile))
+	assert.FileExists(t, filepath.Join(dir, importsFile))
+
+	// The files now exist and are not overwritten.
+	stdout.Reset()
+	code = run([]string{"-replay", filepath.Join("testdata", "model.json"), "-out", dir}, &stdout)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), "refusing to overwrite")
+}
+
+func TestRunUsage(t *testing.T) {
+	var stdout bytes.Buffer
+	assert.Equal(t, 1, run(nil, &stdout))
+	assert.Contains(t, stdout.String(), "Usage: juju-tf-exporter")
+
+	stdout.Reset()
+	assert.Equal(t, 1, run([]string{"--unknown"}, &stdout))
+	assert.Contains(t, stdout.String(), "Usage: juju-tf-exporter")
+
+	stdout.Reset()
+	assert.Equal(t, 1, run([]string{"-replay", filepath.Join(t.TempDir(), "missing.json")}, &stdout))
+	assert.Contains(t, stdout.String(), "error reading recording")
+}
@@ -0,0 +1,391 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/juju/charm/v12"
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/rpc/params"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+const (
+	mainFile      = "main.tf"
+	importsFile   = "imports.tf"
+	variablesFile = "variables.tf"
+)
+
+// sensitiveConfigRegex matches the application config keys whose values
+// are not written to the generated files. They are replaced by sensitive
+// variables instead.
+var sensitiveConfigRegex = regexp.MustCompile(`(?i)(password|passwd|secret|token|private[-_]?key|api[-_]?key|credential)`)
+
+// modelData holds everything read from the controller about a model. It
+// is serialised as JSON to record a model and to replay it later.
+type modelData struct {
+	UUID         string                                   `json:"uuid"`
+	Name         string                                   `json:"name"`
+	Owner        string                                   `json:"owner"`
+	Cloud        string                                   `json:"cloud"`
+	CloudRegion  string                                   `json:"cloud-region,omitempty"`
+	Constraints  string                                   `json:"constraints,omitempty"`
+	Status       *params.FullStatus                       `json:"status"`
+	Applications map[string]*juju.ReadApplicationResponse `json:"applications"`
+}
+
+// exportResult holds the generated Terraform files, by file name, and the
+// warnings about what could not be exported faithfully.
+type exportResult struct {
+	Files    map[string][]byte
+	Warnings []string
+}
+
+// exporter builds the Terraform files of a single model.
+type exporter struct {
+	data *modelData
+
+	main      *hclwrite.File
+	imports   *hclwrite.File
+	variables *hclwrite.File
+
+	modelLabel string
+	appLabels  map[string]string
+	usedLabels map[string]bool
+	warnings   []string
+}
+
+// generateTerraform generates the Terraform configuration of a model: the
+// resources in main.tf, the import blocks which bring the existing objects
+// under Terraform management in imports.tf, and the sensitive variables in
+// variables.tf.
+func generateTerraform(data *modelData) (*exportResult, error) {
+	if data.Status == nil {
+		return nil, fmt.Errorf("missing status of model %q", data.Name)
+	}
+	e := &exporter{
+		data:       data,
+		main:       hclwrite.NewEmptyFile(),
+		imports:    hclwrite.NewEmptyFile(),
+		variables:  hclwrite.NewEmptyFile(),
+		appLabels:  make(map[string]string),
+		usedLabels: make(map[string]bool),
+	}
+
+	e.writeTerraformBlock()
+	e.writeModel()
+	for _, name := range slices.Sorted(maps.Keys(data.Status.Applications)) {
+		if err := e.writeApplication(name); err != nil {
+			return nil, err
+		}
+	}
+	for _, relation := range data.Status.Relations {
+		e.writeIntegration(relation)
+	}
+	for _, name := range slices.Sorted(maps.Keys(data.Status.Offers)) {
+		e.writeOffer(data.Status.Offers[name])
+	}
+
+	result := &exportResult{
+		Files: map[string][]byte{
+			mainFile:    trimBlankLines(e.main.Bytes()),
+			importsFile: trimBlankLines(e.imports.Bytes()),
+		},
+		Warnings: e.warnings,
+	}
+	if len(e.variables.Body().Blocks()) > 0 {
+		result.Files[variablesFile] = trimBlankLines(e.variables.Bytes())
+	}
+	return result, nil
+}
+
+func (e *exporter) writeTerraformBlock() {
+	terraform := e.main.Body().AppendNewBlock("terraform", nil)
+	providers := terraform.Body().AppendNewBlock("required_providers", nil)
+	providers.Body().SetAttributeValue("juju", cty.ObjectVal(map[string]cty.Value{
+		"source":  cty.StringVal("juju/juju"),
+		"version": cty.StringVal(">= 1.0.0"),
+	}))
+	e.main.Body().AppendNewline()
+	e.main.Body().AppendNewBlock("provider", []string{"juju"})
+	e.main.Body().AppendNewline()
+}
+
+func (e *exporter) writeModel() {
+	e.modelLabel = e.label("juju_model", e.data.Name)
+	model := e.appendResource("juju_model", e.modelLabel)
+	model.SetAttributeValue("name", cty.StringVal(e.data.Name))
+	if e.data.Constraints != "" {
+		model.SetAttributeValue("constraints", cty.StringVal(e.data.Constraints))
+	}
+	cloud := model.AppendNewBlock("cloud", nil).Body()
+	cloud.SetAttributeValue("name", cty.StringVal(e.data.Cloud))
+	if e.data.CloudRegion != "" {
+		cloud.SetAttributeValue("region", cty.StringVal(e.data.CloudRegion))
+	}
+	e.appendImport("juju_model", e.modelLabel, e.data.UUID)
+}
+
+func (e *exporter) writeApplication(name string) error {
+	status := e.data.Status.Applications[name]
+	app, ok := e.data.Applications[name]
+	if !ok {
+		return fmt.Errorf("missing details of application %q", name)
+	}
+	curl, err := charm.ParseURL(status.Charm)
+	if err != nil {
+		return fmt.Errorf("parsing charm URL of application %q: %w", name, err)
+	}
+	if curl.Schema == string(charm.Local) {
+		e.warn("application %q is deployed from a local charm, set the charm path manually", name)
+	}
+
+	label := e.label("juju_application", name)
+	e.appLabels[name] = label
+	body := e.appendResource("juju_application", label)
+	body.SetAttributeValue("name", cty.StringVal(name))
+	body.SetAttributeTraversal("model_uuid", e.modelUUIDTraversal())
+	if app.Principal {
+		body.SetAttributeValue("units", cty.NumberIntVal(int64(app.Units)))
+	}
+	if app.Trust {
+		body.SetAttributeValue("trust", cty.True)
+	}
+	if constraints := app.Constraints.String(); constraints != "" {
+		body.SetAttributeValue("constraints", cty.StringVal(constraints))
+	}
+	e.writeApplicationConfig(body, label, name, app.Config)
+	writeEndpointBindings(body, app.EndpointBindings)
+
+	charmBody := body.AppendNewBlock("charm", nil).Body()
+	charmBody.SetAttributeValue("name", cty.StringVal(curl.Name))
+	if app.Channel != "" {
+		charmBody.SetAttributeValue("channel", cty.StringVal(app.Channel))
+	}
+	if app.Revision > 0 {
+		charmBody.SetAttributeValue("revision", cty.NumberIntVal(int64(app.Revision)))
+	}
+	if app.Base != "" {
+		charmBody.SetAttributeValue("base", cty.StringVal(app.Base))
+	}
+
+	if app.Expose != nil {
+		exposeBody := body.AppendNewBlock("expose", nil).Body()
+		for _, key := range []string{"endpoints", "spaces", "cidrs"} {
+			if value, ok := app.Expose[key].(string); ok && value != "" {
+				exposeBody.SetAttributeValue(key, cty.StringVal(value))
+			}
+		}
+	}
+	e.appendImport("juju_application", label, fmt.Sprintf("%s:%s", e.data.UUID, name))
+	return nil
+}
+
+// writeApplicationConfig sets the config of an application to its
+// non-default values. Sensitive values are replaced by references to
+// sensitive variables.
+func (e *exporter) writeApplicationConfig(body *hclwrite.Body, label, appName string, config map[string]juju.ConfigEntry) {
+	var attrs []hclwrite.ObjectAttrTokens
+	for _, key := range slices.Sorted(maps.Keys(config)) {
+		entry := config[key]
+		if entry.IsDefault {
+			continue
+		}
+		value := hclwrite.TokensForValue(cty.StringVal(entry.String()))
+		if sensitiveConfigRegex.MatchString(key) {
+			variable := e.appendSensitiveVariable(label+"_"+terraformName(key),
+				fmt.Sprintf("Value of the %s config of the %s application.", key, appName))
+			value = hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: variable},
+			})
+		}
+		attrs = append(attrs, hclwrite.ObjectAttrTokens{
+			Name:  objectKeyTokens(key),
+			Value: value,
+		})
+	}
+	if len(attrs) > 0 {
+		body.SetAttributeRaw("config", hclwrite.TokensForObject(attrs))
+	}
+}
+
+// writeEndpointBindings sets the endpoint bindings of an application. The
+// binding with an empty endpoint is the default space of the application.
+func writeEndpointBindings(body *hclwrite.Body, bindings map[string]string) {
+	if len(bindings) == 0 {
+		return
+	}
+	// Write one binding per line, hclwrite.TokensForTuple would put them
+	// all on the same line.
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")},
+		{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+	}
+	for _, endpoint := range slices.Sorted(maps.Keys(bindings)) {
+		binding := map[string]cty.Value{"space": cty.StringVal(bindings[endpoint])}
+		if endpoint != "" {
+			binding["endpoint"] = cty.StringVal(endpoint)
+		}
+		tokens = append(tokens, hclwrite.TokensForValue(cty.ObjectVal(binding))...)
+		tokens = append(tokens,
+			&hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")},
+			&hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+		)
+	}
+	tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+	body.SetAttributeRaw("endpoint_bindings", tokens)
+}
+
+func (e *exporter) writeIntegration(relation params.RelationStatus) {
+	// Peer relations are managed by Juju, they have a single endpoint.
+	if len(relation.Endpoints) != 2 {
+		return
+	}
+	endpoints := slices.Clone(relation.Endpoints)
+	if endpoints[1].Role == "provider" {
+		endpoints[0], endpoints[1] = endpoints[1], endpoints[0]
+	}
+
+	label := e.label("juju_integration", endpoints[0].ApplicationName+"_"+endpoints[1].ApplicationName)
+	body := e.appendResource("juju_integration", label)
+	body.SetAttributeTraversal("model_uuid", e.modelUUIDTraversal())
+	for _, endpoint := range endpoints {
+		appBody := body.AppendNewBlock("application", nil).Body()
+		if remote, ok := e.data.Status.RemoteApplications[endpoint.ApplicationName]; ok {
+			appBody.SetAttributeValue("offer_url", cty.StringVal(remote.OfferURL))
+		} else if appLabel, ok := e.appLabels[endpoint.ApplicationName]; ok {
+			appBody.SetAttributeTraversal("name", resourceTraversal("juju_application", appLabel, "name"))
+		} else {
+			appBody.SetAttributeValue("name", cty.StringVal(endpoint.ApplicationName))
+		}
+		appBody.SetAttributeValue("endpoint", cty.StringVal(endpoint.Name))
+	}
+	e.appendImport("juju_integration", label, fmt.Sprintf("%s:%s:%s:%s:%s", e.data.UUID,
+		endpoints[0].ApplicationName, endpoints[0].Name, endpoints[1].ApplicationName, endpoints[1].Name))
+}
+
+func (e *exporter) writeOffer(offer params.ApplicationOfferStatus) {
+	label := e.label("juju_offer", offer.OfferName)
+	body := e.appendResource("juju_offer", label)
+	body.SetAttributeTraversal("model_uuid", e.modelUUIDTraversal())
+	body.SetAttributeValue("name", cty.StringVal(offer.OfferName))
+	if appLabel, ok := e.appLabels[offer.ApplicationName]; ok {
+		body.SetAttributeTraversal("application_name", resourceTraversal("juju_application", appLabel, "name"))
+	} else {
+		body.SetAttributeValue("application_name", cty.StringVal(offer.ApplicationName))
+	}
+	var endpoints []cty.Value
+	for _, alias := range slices.Sorted(maps.Keys(offer.Endpoints)) {
+		endpoint := offer.Endpoints[alias].Name
+		if alias != endpoint {
+			e.warn("endpoint %q of offer %q is offered as %q, endpoint aliases are not supported", endpoint, offer.OfferName, alias)
+		}
+		endpoints = append(endpoints, cty.StringVal(endpoint))
+	}
+	if len(endpoints) > 0 {
+		body.SetAttributeValue("endpoints", cty.ListVal(endpoints))
+	}
+	e.appendImport("juju_offer", label, crossmodel.MakeURL(e.data.Owner, e.data.Name, offer.OfferName, ""))
+}
+
+// appendResource appends a resource block to main.tf and returns its body.
+func (e *exporter) appendResource(resourceType, label string) *hclwrite.Body {
+	block := e.main.Body().AppendNewBlock("resource", []string{resourceType, label})
+	e.main.Body().AppendNewline()
+	return block.Body()
+}
+
+// appendImport appends the import block of a resource to imports.tf.
+func (e *exporter) appendImport(resourceType, label, id string) {
+	body := e.imports.Body().AppendNewBlock("import", nil).Body()
+	body.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	body.SetAttributeValue("id", cty.StringVal(id))
+	e.imports.Body().AppendNewline()
+}
+
+// appendSensitiveVariable appends a sensitive string variable to
+// variables.tf and returns its name.
+func (e *exporter) appendSensitiveVariable(name, description string) string {
+	name = e.label("var", name)
+	body := e.variables.Body().AppendNewBlock("variable", []string{name}).Body()
+	body.SetAttributeValue("description", cty.StringVal(description))
+	body.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+	body.SetAttributeValue("sensitive", cty.True)
+	e.variables.Body().AppendNewline()
+	return name
+}
+
+// label returns a Terraform name for an object, which is unique among the
+// blocks of the same type.
+func (e *exporter) label(blockType, name string) string {
+	label := terraformName(name)
+	for i := 2; e.usedLabels[blockType+"."+label]; i++ {
+		label = fmt.Sprintf("%s_%d", terraformName(name), i)
+	}
+	e.usedLabels[blockType+"."+label] = true
+	return label
+}
+
+func (e *exporter) modelUUIDTraversal() hcl.Traversal {
+	return resourceTraversal("juju_model", e.modelLabel, "uuid")
+}
+
+func (e *exporter) warn(format string, args ...any) {
+	e.warnings = append(e.warnings, fmt.Sprintf(format, args...))
+}
+
+// terraformName converts a Juju name into an idiomatic Terraform name.
+func terraformName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	converted := b.String()
+	if converted == "" || (converted[0] >= '0' && converted[0] <= '9') {
+		converted = "_" + converted
+	}
+	return converted
+}
+
+// objectKeyTokens returns the tokens of an object key, which is quoted
+// unless it is a valid identifier.
+func objectKeyTokens(key string) hclwrite.Tokens {
+	if hclsyntax.ValidIdentifier(key) {
+		return hclwrite.TokensForIdentifier(key)
+	}
+	return hclwrite.TokensForValue(cty.StringVal(key))
+}
+
+func resourceTraversal(resourceType, label, attribute string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+		hcl.TraverseAttr{Name: attribute},
+	}
+}
+
+// trimBlankLines removes the trailing blank line left after the last
+// block of a file.
+func trimBlankLines(src []byte) []byte {
+	return append([]byte(strings.TrimRight(string(hclwrite.Format(src)), "\n")), '\n')
+}
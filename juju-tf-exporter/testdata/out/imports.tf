@@ -0,0 +1,39 @@
+import {
+  to = juju_model.blog
+  id = "4ffb2226-6ced-458b-8b38-5143ca190f75"
+}
+
+import {
+  to = juju_application.mysql
+  id = "4ffb2226-6ced-458b-8b38-5143ca190f75:mysql"
+}
+
+import {
+  to = juju_application.nrpe
+  id = "4ffb2226-6ced-458b-8b38-5143ca190f75:nrpe"
+}
+
+import {
+  to = juju_application.wordpress
+  id = "4ffb2226-6ced-458b-8b38-5143ca190f75:wordpress"
+}
+
+import {
+  to = juju_integration.mysql_wordpress
+  id = "4ffb2226-6ced-458b-8b38-5143ca190f75:mysql:database:wordpress:database"
+}
+
+import {
+  to = juju_integration.wordpress_nrpe
+  id = "4ffb2226-6ced-458b-8b38-5143ca190f75:wordpress:juju-info:nrpe:general-info"
+}
+
+import {
+  to = juju_integration.redis_wordpress
+  id = "4ffb2226-6ced-458b-8b38-5143ca190f75:redis:redis:wordpress:cache"
+}
+
+import {
+  to = juju_offer.mysql
+  id = "admin/blog.mysql"
+}
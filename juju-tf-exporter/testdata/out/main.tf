@@ -0,0 +1,125 @@
+terraform {
+  required_providers {
+    juju = {
+      source  = "juju/juju"
+      version = ">= 1.0.0"
+    }
+  }
+}
+
+provider "juju" {
+}
+
+resource "juju_model" "blog" {
+  name        = "blog"
+  constraints = "arch=amd64"
+  cloud {
+    name   = "localhost"
+    region = "localhost"
+  }
+}
+
+resource "juju_application" "mysql" {
+  name        = "mysql"
+  model_uuid  = juju_model.blog.uuid
+  units       = 1
+  trust       = true
+  constraints = "arch=amd64"
+  config = {
+    profile-limit-memory = "2048"
+  }
+  charm {
+    name     = "mysql"
+    channel  = "8.0/stable"
+    revision = 151
+    base     = "ubuntu@22.04"
+  }
+}
+
+resource "juju_application" "nrpe" {
+  name       = "nrpe"
+  model_uuid = juju_model.blog.uuid
+  config = {
+    nagios_host_context = "blog"
+  }
+  charm {
+    name     = "nrpe"
+    channel  = "latest/stable"
+    revision = 117
+    base     = "ubuntu@22.04"
+  }
+}
+
+resource "juju_application" "wordpress" {
+  name        = "wordpress"
+  model_uuid  = juju_model.blog.uuid
+  units       = 2
+  constraints = "arch=amd64 mem=4096M"
+  config = {
+    admin-password = var.wordpress_admin_password
+    blog-title     = "My blog"
+    "smtp.api_key" = var.wordpress_smtp_api_key
+  }
+  endpoint_bindings = [
+    {
+      space = "public"
+    },
+    {
+      endpoint = "database"
+      space    = "internal"
+    },
+  ]
+  charm {
+    name     = "wordpress-k8s"
+    channel  = "latest/edge"
+    revision = 5
+    base     = "ubuntu@22.04"
+  }
+  expose {
+    endpoints = "website"
+    cidrs     = "0.0.0.0/0"
+  }
+}
+
+resource "juju_integration" "mysql_wordpress" {
+  model_uuid = juju_model.blog.uuid
+  application {
+    name     = juju_application.mysql.name
+    endpoint = "database"
+  }
+  application {
+    name     = juju_application.wordpress.name
+    endpoint = "database"
+  }
+}
+
+resource "juju_integration" "wordpress_nrpe" {
+  model_uuid = juju_model.blog.uuid
+  application {
+    name     = juju_application.wordpress.name
+    endpoint = "juju-info"
+  }
+  application {
+    name     = juju_application.nrpe.name
+    endpoint = "general-info"
+  }
+}
+
+resource "juju_integration" "redis_wordpress" {
+  model_uuid = juju_model.blog.uuid
+  application {
+    offer_url = "admin/cache.redis"
+    endpoint  = "redis"
+  }
+  application {
+    name     = juju_application.wordpress.name
+    endpoint = "cache"
+  }
+}
+
+resource "juju_offer" "mysql" {
+  model_uuid       = juju_model.blog.uuid
+  name             = "mysql"
+  application_name = juju_application.mysql.name
+  endpoints        = ["database"]
+}
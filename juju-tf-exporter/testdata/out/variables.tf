@@ -0,0 +1,11 @@
+variable "wordpress_admin_password" {
+  description = "Value of the admin-password config of the wordpress application."
+  type        = string
+  sensitive   = true
+}
+
+variable "wordpress_smtp_api_key" {
+  description = "Value of the smtp.api_key config of the wordpress application."
+  type        = string
+  sensitive   = true
+}
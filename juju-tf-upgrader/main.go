@@ -4,7 +4,10 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -13,59 +16,120 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/zclconf/go-cty/cty"
 )
 
 const version0Regex = `version\s*=\s*"\s*([~><=!]*\s*)?0\.\d+\.\d+(?:-[^"]+)?"`
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: juju-tf-upgrader <terraform-file-or-directory>")
-		os.Exit(1)
+	os.Exit(run(os.Args[1:], os.Stdout))
+}
+
+// options holds the command line options.
+type options struct {
+	// check reports the files which need upgrading without writing them,
+	// and fails if there are any.
+	check bool
+	// diff prints the changes as unified diffs without writing the files.
+	diff bool
+	// format is the output format, text or json.
+	format string
+}
+
+// dryRun returns whether the files are left untouched.
+func (o options) dryRun() bool {
+	return o.check || o.diff
+}
+
+// run upgrades the Terraform files given in args, writes its output to
+// stdout and returns the exit code.
+func run(args []string, stdout io.Writer) int {
+	var opts options
+	flags := flag.NewFlagSet("juju-tf-upgrader", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	flags.BoolVar(&opts.check, "check", false, "do not write the files, exit with a non-zero status if any file needs upgrading")
+	flags.BoolVar(&opts.diff, "diff", false, "do not write the files, print the changes as unified diffs")
+	flags.StringVar(&opts.format, "format", "text", "output format, text or json")
+	flags.Usage = func() {
+		fmt.Fprintln(stdout, "Usage: juju-tf-upgrader [--check] [--diff] [--format=text|json] <terraform-file-or-directory>")
+		flags.PrintDefaults()
+	}
+	// The flag package stops parsing at the first positional argument,
+	// parse the flags following it too, e.g. "juju-tf-upgrader . --check".
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			return 1
+		}
+		if flags.NArg() == 0 {
+			break
+		}
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+	if len(positional) != 1 || (opts.format != "text" && opts.format != "json") {
+		flags.Usage()
+		return 1
 	}
 
-	target := os.Args[1]
+	target := positional[0]
 
 	filesToProcess, err := discoverTerraformFiles(target)
 	if err != nil {
-		fmt.Printf("%v\n", err)
-		os.Exit(1)
+		fmt.Fprintf(stdout, "%v\n", err)
+		return 1
 	}
 
-	if len(filesToProcess) == 0 {
-		fmt.Println("No .tf files found to process")
-		return
-	}
-
-	fmt.Printf("Found %d Terraform files to process:\n", len(filesToProcess))
-	for _, file := range filesToProcess {
-		fmt.Printf("  - %s\n", file)
+	reports := make([]fileReport, 0, len(filesToProcess))
+	for _, filename := range filesToProcess {
+		reports = append(reports, processFile(filename, opts))
 	}
-	fmt.Println()
-
-	totalUpgraded := 0
-	totalWarnings := 0
+	summary := newRunSummary(reports)
 
-	for _, filename := range filesToProcess {
-		upgraded, warnings := processFile(filename)
-		if upgraded {
-			totalUpgraded++
+	if opts.format == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(summary); err != nil {
+			fmt.Fprintf(stdout, "%v\n", err)
+			return 1
 		}
-		totalWarnings += warnings
+	} else {
+		printTextSummary(stdout, summary, opts)
 	}
 
-	fmt.Printf("\nSummary: %d out of %d files were upgraded\n", totalUpgraded, len(filesToProcess))
-	if totalWarnings > 0 {
-		fmt.Printf("⚠️  Total warnings: %d variable(s) flagged for manual review across all files\n", totalWarnings)
-		fmt.Println("Please review variables named 'model', 'model_name', or containing 'model_name' to ensure they use UUIDs instead of names where appropriate.")
+	if opts.check && (summary.Upgraded > 0 || summary.Errors > 0) {
+		return 1
 	}
+	return 0
 }
 
 // transformationResult holds the result of transforming a Terraform file
 type transformationResult struct {
 	ModifiedContent []byte
 	WasUpgraded     bool
-	Warnings        int
+	// Transformations describes the changes made to the file.
+	Transformations []string
+	// Warnings lists what needs manual review.
+	Warnings []*transformationWarning
+}
+
+// transformationWarning describes a block of a file which needs manual review.
+type transformationWarning struct {
+	Line        int    `json:"line"`
+	Message     string `json:"message"`
+	Description string `json:"description,omitempty"`
+}
+
+func (r *transformationResult) addTransformation(format string, args ...any) {
+	r.Transformations = append(r.Transformations, fmt.Sprintf(format, args...))
+	r.WasUpgraded = true
+}
+
+func (r *transformationResult) addWarning(line int, format string, args ...any) *transformationWarning {
+	warning := &transformationWarning{Line: line, Message: fmt.Sprintf(format, args...)}
+	r.Warnings = append(r.Warnings, warning)
+	return warning
 }
 
 // transformTerraformFile processes Terraform file content and returns the upgraded content
@@ -83,8 +147,10 @@ func transformTerraformFile(src []byte, filename string) (*transformationResult,
 		return nil, fmt.Errorf("error parsing HCL: %v", diags)
 	}
 
-	upgraded := false
-	warnings := 0
+	result := &transformationResult{
+		Transformations: []string{},
+		Warnings:        []*transformationWarning{},
+	}
 
 	// Create a map of block labels to source blocks for line number lookup
 	srcBlockMap := make(map[string]*hclsyntax.Block)
@@ -105,72 +171,185 @@ func transformTerraformFile(src []byte, filename string) (*transformationResult,
 
 		switch block.Type() {
 		case "resource":
-			processResourceBlockModelUUID(block, filename, &upgraded)
-			processResourceBlockDeprecatedFields(block, filename, srcBlockMap, blockKey, &upgraded, &warnings)
+			processResourceBlockModelUUID(block, result)
+			processResourceBlockDeprecatedFields(block, srcBlockMap, blockKey, result)
 		case "output":
-			processOutputBlock(block, filename, &upgraded)
+			processOutputBlock(block, result)
 		case "variable":
-			processVariableBlock(block, filename, srcBlockMap, blockKey, &warnings)
+			processVariableBlock(block, srcBlockMap, blockKey, result)
 		case "data":
-			processDataBlock(block, filename, &upgraded, srcBlockMap, blockKey, &warnings)
-			processModelDataSource(block, filename, &upgraded, srcBlockMap, blockKey, &warnings)
+			processDataBlock(block, srcBlockMap, blockKey, result)
+			processModelDataSource(block, srcBlockMap, blockKey, result)
 		case "terraform":
-			processTerraformBlock(block, filename, &upgraded)
+			processTerraformBlock(block, result)
 		}
 	}
 
-	return &transformationResult{
-		ModifiedContent: f.Bytes(),
-		WasUpgraded:     upgraded,
-		Warnings:        warnings,
-	}, nil
+	result.ModifiedContent = f.Bytes()
+	return result, nil
 }
 
-func processFile(filename string) (bool, int) {
-	fmt.Printf("Processing: %s\n", filename)
+// fileReport is the outcome of processing a single file.
+type fileReport struct {
+	File            string                   `json:"file"`
+	Upgraded        bool                     `json:"upgraded"`
+	Written         bool                     `json:"written"`
+	Transformations []string                 `json:"transformations"`
+	Warnings        []*transformationWarning `json:"warnings"`
+	Diff            string                   `json:"diff,omitempty"`
+	Error           string                   `json:"error,omitempty"`
+}
+
+// processFile upgrades a file. The file is only written when it needs
+// upgrading and the options are not a dry run.
+func processFile(filename string, opts options) fileReport {
+	report := fileReport{
+		File:            filename,
+		Transformations: []string{},
+		Warnings:        []*transformationWarning{},
+	}
 
 	// Get original file info to preserve permissions
 	fileInfo, err := os.Stat(filename)
 	if err != nil {
-		fmt.Printf("  Error getting file info: %v\n", err)
-		return false, 0
+		report.Error = fmt.Sprintf("Error getting file info: %v", err)
+		return report
 	}
 
 	src, err := os.ReadFile(filename)
 	if err != nil {
-		fmt.Printf("  Error reading file: %v\n", err)
-		return false, 0
+		report.Error = fmt.Sprintf("Error reading file: %v", err)
+		return report
 	}
 
 	result, err := transformTerraformFile(src, filename)
 	if err != nil {
-		fmt.Printf("  Error transforming file: %v\n", err)
-		return false, 0
+		report.Error = fmt.Sprintf("Error transforming file: %v", err)
+		return report
 	}
+	report.Upgraded = result.WasUpgraded
+	report.Transformations = result.Transformations
+	report.Warnings = result.Warnings
 
-	if result.WasUpgraded {
-		// Write the upgraded content back to the original file with original permissions
-		err = os.WriteFile(filename, result.ModifiedContent, fileInfo.Mode())
+	if !result.WasUpgraded {
+		return report
+	}
+	if opts.diff {
+		report.Diff, err = unifiedDiff(filename, src, result.ModifiedContent)
 		if err != nil {
-			fmt.Printf("  Error writing file: %v\n", err)
-			return false, result.Warnings
+			report.Error = fmt.Sprintf("Error computing diff: %v", err)
+			return report
+		}
+	}
+	if opts.dryRun() {
+		return report
+	}
+
+	// Write the upgraded content back to the original file with original permissions
+	err = os.WriteFile(filename, result.ModifiedContent, fileInfo.Mode())
+	if err != nil {
+		report.Error = fmt.Sprintf("Error writing file: %v", err)
+		return report
+	}
+	report.Written = true
+	return report
+}
+
+// unifiedDiff returns the changes made to a file as a unified diff.
+func unifiedDiff(filename string, original, upgraded []byte) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(original)),
+		B:        difflib.SplitLines(string(upgraded)),
+		FromFile: filename,
+		ToFile:   filename + " (upgraded)",
+		Context:  3,
+	})
+}
+
+// runSummary holds the reports of all the processed files.
+type runSummary struct {
+	Files    []fileReport `json:"files"`
+	Total    int          `json:"total"`
+	Upgraded int          `json:"upgraded"`
+	Warnings int          `json:"warnings"`
+	Errors   int          `json:"errors"`
+}
+
+func newRunSummary(reports []fileReport) runSummary {
+	summary := runSummary{Files: reports, Total: len(reports)}
+	for _, report := range reports {
+		if report.Upgraded {
+			summary.Upgraded++
 		}
-		fmt.Printf("  ✓ File updated successfully\n")
+		if report.Error != "" {
+			summary.Errors++
+		}
+		summary.Warnings += len(report.Warnings)
+	}
+	return summary
+}
+
+// printTextSummary prints the reports in a human readable format.
+func printTextSummary(w io.Writer, summary runSummary, opts options) {
+	if summary.Total == 0 {
+		fmt.Fprintln(w, "No .tf files found to process")
+		return
 	}
 
-	if result.Warnings > 0 {
-		fmt.Printf("  ⚠️  %d variable(s) flagged for manual review\n", result.Warnings)
+	fmt.Fprintf(w, "Found %d Terraform files to process:\n", summary.Total)
+	for _, report := range summary.Files {
+		fmt.Fprintf(w, "  - %s\n", report.File)
 	}
+	fmt.Fprintln(w)
 
-	if !result.WasUpgraded && result.Warnings == 0 {
-		fmt.Printf("  - No upgrades needed\n")
+	for _, report := range summary.Files {
+		fmt.Fprintf(w, "Processing: %s\n", report.File)
+		if report.Error != "" {
+			fmt.Fprintf(w, "  %s\n", report.Error)
+			continue
+		}
+		for _, transformation := range report.Transformations {
+			fmt.Fprintf(w, "  ✓ %s\n", transformation)
+		}
+		for _, warning := range report.Warnings {
+			fmt.Fprintf(w, "  ⚠️  WARNING: %s:%d:1 - %s\n", report.File, warning.Line, warning.Message)
+			if warning.Description != "" {
+				fmt.Fprintf(w, "      Description: %s\n", warning.Description)
+			}
+		}
+		switch {
+		case report.Written:
+			fmt.Fprintf(w, "  ✓ File updated successfully\n")
+		case report.Upgraded:
+			fmt.Fprintf(w, "  ✗ File needs upgrading\n")
+		}
+		if len(report.Warnings) > 0 {
+			fmt.Fprintf(w, "  ⚠️  %d variable(s) flagged for manual review\n", len(report.Warnings))
+		}
+		if !report.Upgraded && len(report.Warnings) == 0 {
+			fmt.Fprintf(w, "  - No upgrades needed\n")
+		}
+		if report.Diff != "" {
+			fmt.Fprint(w, report.Diff)
+		}
 	}
 
-	return result.WasUpgraded, result.Warnings
+	if opts.dryRun() {
+		fmt.Fprintf(w, "\nSummary: %d out of %d files need upgrading\n", summary.Upgraded, summary.Total)
+	} else {
+		fmt.Fprintf(w, "\nSummary: %d out of %d files were upgraded\n", summary.Upgraded, summary.Total)
+	}
+	if summary.Warnings > 0 {
+		fmt.Fprintf(w, "⚠️  Total warnings: %d variable(s) flagged for manual review across all files\n", summary.Warnings)
+		fmt.Fprintln(w, "Please review variables named 'model', 'model_name', or containing 'model_name' to ensure they use UUIDs instead of names where appropriate.")
+	}
+	if opts.check && summary.Upgraded > 0 {
+		fmt.Fprintln(w, "Check failed: run juju-tf-upgrader without --check to upgrade the files.")
+	}
 }
 
 // processResourceBlockModelUUID handles resource blocks that need model -> model_uuid transformation
-func processResourceBlockModelUUID(block *hclwrite.Block, _ string, upgraded *bool) {
+func processResourceBlockModelUUID(block *hclwrite.Block, result *transformationResult) {
 	if len(block.Labels()) < 2 {
 		return
 	}
@@ -230,13 +409,12 @@ func processResourceBlockModelUUID(block *hclwrite.Block, _ string, upgraded *bo
 		if sourceField != targetField {
 			block.Body().RemoveAttribute(sourceField)
 		}
-		*upgraded = true
 
 		referenceType := getReferenceType(attrStr)
 		if sourceField == targetField {
-			fmt.Printf("  ✓ Upgraded %s.%s: %s reference .name -> .uuid (%s reference)\n", resourceType, block.Labels()[1], sourceField, referenceType)
+			result.addTransformation("Upgraded %s.%s: %s reference .name -> .uuid (%s reference)", resourceType, block.Labels()[1], sourceField, referenceType)
 		} else {
-			fmt.Printf("  ✓ Upgraded %s.%s: %s -> %s (%s reference)\n", resourceType, block.Labels()[1], sourceField, targetField, referenceType)
+			result.addTransformation("Upgraded %s.%s: %s -> %s (%s reference)", resourceType, block.Labels()[1], sourceField, targetField, referenceType)
 		}
 	} else if isVariableRef {
 		// For variable references, just change the field name (keep the variable name the same)
@@ -245,18 +423,17 @@ func processResourceBlockModelUUID(block *hclwrite.Block, _ string, upgraded *bo
 		if sourceField != targetField {
 			block.Body().RemoveAttribute(sourceField)
 		}
-		*upgraded = true
 
 		if sourceField == targetField {
-			fmt.Printf("  ✓ Upgraded %s.%s: %s with variable reference (field name unchanged)\n", resourceType, block.Labels()[1], sourceField)
+			result.addTransformation("Upgraded %s.%s: %s with variable reference (field name unchanged)", resourceType, block.Labels()[1], sourceField)
 		} else {
-			fmt.Printf("  ✓ Upgraded %s.%s: %s -> %s (variable reference)\n", resourceType, block.Labels()[1], sourceField, targetField)
+			result.addTransformation("Upgraded %s.%s: %s -> %s (variable reference)", resourceType, block.Labels()[1], sourceField, targetField)
 		}
 	}
 }
 
 // processOutputBlock handles output blocks that reference juju_model.*.name
-func processOutputBlock(block *hclwrite.Block, _ string, upgraded *bool) {
+func processOutputBlock(block *hclwrite.Block, result *transformationResult) {
 	if len(block.Labels()) < 1 {
 		return
 	}
@@ -280,14 +457,13 @@ func processOutputBlock(block *hclwrite.Block, _ string, upgraded *bool) {
 
 	// Update the output value
 	block.Body().SetAttributeTraversal("value", traversal.Traversal)
-	*upgraded = true
 
 	referenceType := getReferenceType(attrStr)
-	fmt.Printf("  ✓ Upgraded output.%s: .name -> .uuid (%s reference)\n", block.Labels()[0], referenceType)
+	result.addTransformation("Upgraded output.%s: .name -> .uuid (%s reference)", block.Labels()[0], referenceType)
 }
 
 // processVariableBlock handles variable blocks that might need manual review
-func processVariableBlock(block *hclwrite.Block, filename string, srcBlockMap map[string]*hclsyntax.Block, blockKey string, warnings *int) {
+func processVariableBlock(block *hclwrite.Block, srcBlockMap map[string]*hclsyntax.Block, blockKey string, result *transformationResult) {
 	if len(block.Labels()) < 1 {
 		return
 	}
@@ -304,8 +480,7 @@ func processVariableBlock(block *hclwrite.Block, filename string, srcBlockMap ma
 		lineNum = srcBlock.DefRange().Start.Line
 	}
 
-	*warnings++
-	fmt.Printf("  ⚠️  WARNING: %s:%d:1 - Variable '%s' may need review - check if it should use model UUID instead of name\n", filename, lineNum, varName)
+	warning := result.addWarning(lineNum, "Variable '%s' may need review - check if it should use model UUID instead of name", varName)
 
 	// Check if there's a description that mentions "model"
 	if descAttr := block.Body().GetAttribute("description"); descAttr != nil {
@@ -313,13 +488,13 @@ func processVariableBlock(block *hclwrite.Block, filename string, srcBlockMap ma
 		tokens := expr.BuildTokens(nil)
 		descStr := strings.Trim(strings.Trim(string(tokens.Bytes()), "\""), " ")
 		if strings.Contains(strings.ToLower(descStr), "model") {
-			fmt.Printf("      Description: %s\n", descStr)
+			warning.Description = descStr
 		}
 	}
 }
 
 // processDataBlock handles data source blocks that reference juju_model.*.name
-func processDataBlock(block *hclwrite.Block, filename string, upgraded *bool, srcBlockMap map[string]*hclsyntax.Block, blockKey string, warnings *int) {
+func processDataBlock(block *hclwrite.Block, srcBlockMap map[string]*hclsyntax.Block, blockKey string, result *transformationResult) {
 	if len(block.Labels()) < 2 {
 		return
 	}
@@ -371,8 +546,7 @@ func processDataBlock(block *hclwrite.Block, filename string, upgraded *bool, sr
 			lineNum = srcBlock.DefRange().Start.Line
 		}
 
-		*warnings++
-		fmt.Printf("  ⚠️  WARNING: %s:%d:1 - Data source '%s' may need review - check if it should use model UUID instead of name\n", filename, lineNum, dataSourceName)
+		result.addWarning(lineNum, "Data source '%s' may need review - check if it should use model UUID instead of name", dataSourceName)
 		return
 	}
 
@@ -388,13 +562,12 @@ func processDataBlock(block *hclwrite.Block, filename string, upgraded *bool, sr
 		if sourceField != targetField {
 			block.Body().RemoveAttribute(sourceField)
 		}
-		*upgraded = true
 
 		referenceType := getReferenceType(attrStr)
 		if sourceField == targetField {
-			fmt.Printf("  ✓ Upgraded %s.%s: %s reference .name -> .uuid (%s reference)\n", dataSourceType, block.Labels()[1], sourceField, referenceType)
+			result.addTransformation("Upgraded %s.%s: %s reference .name -> .uuid (%s reference)", dataSourceType, block.Labels()[1], sourceField, referenceType)
 		} else {
-			fmt.Printf("  ✓ Upgraded %s.%s: %s -> %s (%s reference)\n", dataSourceType, block.Labels()[1], sourceField, targetField, referenceType)
+			result.addTransformation("Upgraded %s.%s: %s -> %s (%s reference)", dataSourceType, block.Labels()[1], sourceField, targetField, referenceType)
 		}
 	} else if isVariableRef {
 		// For variable references, just change the field name (keep the variable name the same)
@@ -403,18 +576,17 @@ func processDataBlock(block *hclwrite.Block, filename string, upgraded *bool, sr
 		if sourceField != targetField {
 			block.Body().RemoveAttribute(sourceField)
 		}
-		*upgraded = true
 
 		if sourceField == targetField {
-			fmt.Printf("  ✓ Upgraded %s.%s: %s with variable reference (field name unchanged)\n", dataSourceType, block.Labels()[1], sourceField)
+			result.addTransformation("Upgraded %s.%s: %s with variable reference (field name unchanged)", dataSourceType, block.Labels()[1], sourceField)
 		} else {
-			fmt.Printf("  ✓ Upgraded %s.%s: %s -> %s (variable reference)\n", dataSourceType, block.Labels()[1], sourceField, targetField)
+			result.addTransformation("Upgraded %s.%s: %s -> %s (variable reference)", dataSourceType, block.Labels()[1], sourceField, targetField)
 		}
 	}
 }
 
 // processModelDataSource handles model data sources that need to add the "owner" field.
-func processModelDataSource(block *hclwrite.Block, filename string, upgraded *bool, srcBlockMap map[string]*hclsyntax.Block, blockKey string, warnings *int) {
+func processModelDataSource(block *hclwrite.Block, srcBlockMap map[string]*hclsyntax.Block, blockKey string, result *transformationResult) {
 	// Only operate on data "juju_model" blocks
 	if len(block.Labels()) < 2 || block.Labels()[0] != "juju_model" {
 		return
@@ -427,7 +599,7 @@ func processModelDataSource(block *hclwrite.Block, filename string, upgraded *bo
 
 	// Add the owner attribute with a placeholder value
 	block.Body().SetAttributeValue("owner", cty.StringVal("### FILL IN OWNER"))
-	*upgraded = true
+	result.addTransformation("Added placeholder 'owner' field to data.juju_model.%s", block.Labels()[1])
 
 	// Get line number from source block
 	lineNum := 0
@@ -435,12 +607,11 @@ func processModelDataSource(block *hclwrite.Block, filename string, upgraded *bo
 		lineNum = srcBlock.DefRange().Start.Line
 	}
 
-	*warnings++
-	fmt.Printf("  ⚠️  WARNING: %s:%d:1 - data.juju_model.%s missing required 'owner' field. Added placeholder, please update with correct value.\n", filename, lineNum, block.Labels()[1])
+	result.addWarning(lineNum, "data.juju_model.%s missing required 'owner' field. Added placeholder, please update with correct value.", block.Labels()[1])
 }
 
 // processTerraformBlock handles terraform blocks that need provider version upgrades
-func processTerraformBlock(block *hclwrite.Block, _ string, upgraded *bool) {
+func processTerraformBlock(block *hclwrite.Block, result *transformationResult) {
 	// Look for required_providers block
 	requiredProvidersBlock := block.Body().FirstMatchingBlock("required_providers", nil)
 	if requiredProvidersBlock == nil {
@@ -469,8 +640,7 @@ func processTerraformBlock(block *hclwrite.Block, _ string, upgraded *bool) {
 		})
 		requiredProvidersBlock.Body().SetAttributeRaw("juju", tokens)
 
-		*upgraded = true
-		fmt.Printf("  ✓ Upgraded terraform.required_providers.juju: version 0.x -> ~> 1.0\n")
+		result.addTransformation("Upgraded terraform.required_providers.juju: version 0.x -> ~> 1.0")
 	}
 }
 
@@ -552,7 +722,7 @@ func discoverTerraformFiles(target string) ([]string, error) {
 }
 
 // processResourceBlockDeprecatedFields handles deprecated fields in resource blocks
-func processResourceBlockDeprecatedFields(block *hclwrite.Block, filename string, srcBlockMap map[string]*hclsyntax.Block, blockKey string, upgraded *bool, warnings *int) {
+func processResourceBlockDeprecatedFields(block *hclwrite.Block, srcBlockMap map[string]*hclsyntax.Block, blockKey string, result *transformationResult) {
 	if len(block.Labels()) < 2 {
 		return
 	}
@@ -570,15 +740,13 @@ func processResourceBlockDeprecatedFields(block *hclwrite.Block, filename string
 	case "juju_application":
 		// Handle placement field - warning only
 		if placementAttr := block.Body().GetAttribute("placement"); placementAttr != nil {
-			*warnings++
-			fmt.Printf("  ⚠️  WARNING: %s:%d:1 - %s.%s uses deprecated 'placement' field - use 'machines' instead. See documentation for migration guidance.\n", filename, lineNum, resourceType, resourceName)
+			result.addWarning(lineNum, "%s.%s uses deprecated 'placement' field - use 'machines' instead. See documentation for migration guidance.", resourceType, resourceName)
 		}
 
 		// Handle principal field - remove it
 		if principalAttr := block.Body().GetAttribute("principal"); principalAttr != nil {
 			block.Body().RemoveAttribute("principal")
-			*upgraded = true
-			fmt.Printf("  ✓ Removed deprecated 'principal' field from %s.%s (field was unused)\n", resourceType, resourceName)
+			result.addTransformation("Removed deprecated 'principal' field from %s.%s (field was unused)", resourceType, resourceName)
 		}
 
 		// Handle series field - replace with base
@@ -587,8 +755,7 @@ func processResourceBlockDeprecatedFields(block *hclwrite.Block, filename string
 			expr := seriesAttr.Expr()
 			block.Body().SetAttributeRaw("base", expr.BuildTokens(nil))
 			block.Body().RemoveAttribute("series")
-			*upgraded = true
-			fmt.Printf("  ✓ Upgraded %s.%s: 'series' -> 'base'\n", resourceType, resourceName)
+			result.addTransformation("Upgraded %s.%s: 'series' -> 'base'", resourceType, resourceName)
 		}
 
 	case "juju_machine":
@@ -598,8 +765,7 @@ func processResourceBlockDeprecatedFields(block *hclwrite.Block, filename string
 			expr := seriesAttr.Expr()
 			block.Body().SetAttributeRaw("base", expr.BuildTokens(nil))
 			block.Body().RemoveAttribute("series")
-			*upgraded = true
-			fmt.Printf("  ✓ Upgraded %s.%s: 'series' -> 'base'\n", resourceType, resourceName)
+			result.addTransformation("Upgraded %s.%s: 'series' -> 'base'", resourceType, resourceName)
 		}
 	}
 }
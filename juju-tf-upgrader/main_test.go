@@ -143,3 +143,110 @@ func TestTerraformZeroVersionRegex(t *testing.T) {
 		}
 	}
 }
+
+func TestRunReports(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		goldenFile   string
+		expectedCode int
+	}{
+		{
+			name:         "check",
+			args:         []string{"--check", "in"},
+			goldenFile:   "check.txt",
+			expectedCode: 1,
+		},
+		{
+			name:         "diff",
+			args:         []string{"--diff", "in"},
+			goldenFile:   "diff.txt",
+			expectedCode: 0,
+		},
+		{
+			name:         "json",
+			args:         []string{"--check", "--format=json", "in"},
+			goldenFile:   "report.json",
+			expectedCode: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			assert.Equal(t, tt.expectedCode, run(tt.args, &stdout), "unexpected exit code")
+
+			expectedContent, err := os.ReadFile(filepath.Join("testdata", tt.goldenFile))
+			require.NoError(t, err, "Error reading expected output file")
+
+			if !bytes.Equal(stdout.Bytes(), expectedContent) {
+				// Save actual output for debugging
+				actualFile := filepath.Join("actual_" + tt.goldenFile)
+				_ = os.WriteFile(actualFile, stdout.Bytes(), 0644)
+
+				assert.Equal(t, string(expectedContent), stdout.String(),
+					"Output does not match expected output. Actual output saved to %s", actualFile)
+			}
+		})
+	}
+}
+
+func TestRunCheckLeavesFilesUnchanged(t *testing.T) {
+	dir := t.TempDir()
+	src, err := os.ReadFile(filepath.Join("in", "juju_application_test.tf"))
+	require.NoError(t, err)
+	filename := filepath.Join(dir, "main.tf")
+	require.NoError(t, os.WriteFile(filename, src, 0644))
+
+	var stdout bytes.Buffer
+	assert.Equal(t, 1, run([]string{"--check", "--diff", dir}, &stdout))
+	content, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	assert.Equal(t, string(src), string(content), "file was modified by --check")
+
+	// Upgrade the file, after which the check passes.
+	stdout.Reset()
+	assert.Equal(t, 0, run([]string{dir}, &stdout))
+	assert.Contains(t, stdout.String(), "File updated successfully")
+
+	stdout.Reset()
+	assert.Equal(t, 0, run([]string{"--check", dir}, &stdout))
+	assert.Contains(t, stdout.String(), "Summary: 0 out of 1 files need upgrading")
+}
+
+func TestRunFlagsAfterTarget(t *testing.T) {
+	dir := t.TempDir()
+	src, err := os.ReadFile(filepath.Join("in", "juju_application_test.tf"))
+	require.NoError(t, err)
+	filename := filepath.Join(dir, "main.tf")
+	require.NoError(t, os.WriteFile(filename, src, 0644))
+
+	var stdout bytes.Buffer
+	assert.Equal(t, 1, run([]string{dir, "--check"}, &stdout))
+	assert.Contains(t, stdout.String(), "Summary: 1 out of 1 files need upgrading")
+	content, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	assert.Equal(t, string(src), string(content), "file was modified by --check")
+
+	inFile := filepath.Join("in", "juju_application_test.tf")
+	stdout.Reset()
+	assert.Equal(t, 1, run([]string{"in", "--check", "--format=json"}, &stdout))
+	assert.Contains(t, stdout.String(), `"upgraded": 18`)
+	content, err = os.ReadFile(inFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(src), string(content), "%s was modified by --check", inFile)
+}
+
+func TestRunInvalidArguments(t *testing.T) {
+	var stdout bytes.Buffer
+	assert.Equal(t, 1, run(nil, &stdout))
+	assert.Contains(t, stdout.String(), "Usage: juju-tf-upgrader")
+
+	stdout.Reset()
+	assert.Equal(t, 1, run([]string{"--format=yaml", "in"}, &stdout))
+	assert.Contains(t, stdout.String(), "Usage: juju-tf-upgrader")
+
+	stdout.Reset()
+	assert.Equal(t, 1, run([]string{"in", "out", "--check"}, &stdout))
+	assert.Contains(t, stdout.String(), "Usage: juju-tf-upgrader")
+}